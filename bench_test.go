@@ -0,0 +1,62 @@
+package main
+
+import (
+	"strconv"
+	"testing"
+)
+
+const benchSize = 100_000
+
+var (
+	benchNums  = make([]int, benchSize)
+	benchNames = make([]string, benchSize)
+	benchSink  int
+)
+
+func init() {
+	for i := range benchNums {
+		benchNums[i] = i
+		benchNames[i] = "name" + strconv.Itoa(i)
+	}
+}
+
+func BenchmarkIndexLoop(b *testing.B) {
+	b.Run("int", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchSink = SumIndexLoop(benchNums)
+		}
+	})
+	b.Run("string", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchSink = TotalLenIndexLoop(benchNames)
+		}
+	})
+}
+
+func BenchmarkRangeLoop(b *testing.B) {
+	b.Run("int", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchSink = SumRangeLoop(benchNums)
+		}
+	})
+	b.Run("string", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchSink = TotalLenRangeLoop(benchNames)
+		}
+	})
+}
+
+func BenchmarkAppend(b *testing.B) {
+	b.Run("grow", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchSink = len(AppendGrow(benchSize))
+		}
+	})
+	b.Run("prealloc", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			benchSink = len(AppendPrealloc(benchSize))
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package main
+
+import "errors"
+
+// DemoDataBuilder assembles a DemoData one field at a time. Its methods
+// return the builder so calls can be chained. The zero value is ready to
+// use.
+type DemoDataBuilder struct {
+	data DemoData
+}
+
+// WithName appends name to the record's names.
+func (b *DemoDataBuilder) WithName(name string) *DemoDataBuilder {
+	b.data.Names = append(b.data.Names, name)
+	return b
+}
+
+// AddScore sets the score stored under key to v.
+func (b *DemoDataBuilder) AddScore(key string, v int) *DemoDataBuilder {
+	if b.data.Scores == nil {
+		b.data.Scores = make(map[string]int)
+	}
+	b.data.Scores[key] = v
+	return b
+}
+
+// AddNumber appends n to the record's numbers.
+func (b *DemoDataBuilder) AddNumber(n int) *DemoDataBuilder {
+	b.data.Numbers = append(b.data.Numbers, n)
+	return b
+}
+
+// Build returns the assembled DemoData. It returns an error if no name
+// has been set. The result does not share memory with the builder, so
+// the builder can keep being used afterwards.
+func (b *DemoDataBuilder) Build() (DemoData, error) {
+	if len(b.data.Names) == 0 {
+		return DemoData{}, errors.New("build demo data: at least one name is required")
+	}
+	return DemoData{
+		Names:   CloneSlice(b.data.Names),
+		Scores:  CloneMap(b.data.Scores),
+		Numbers: CloneSlice(b.data.Numbers),
+	}, nil
+}
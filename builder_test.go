@@ -0,0 +1,55 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDemoDataBuilderBuild(t *testing.T) {
+	b := new(DemoDataBuilder).
+		WithName("Raghu").
+		WithName("Mahesh").
+		AddScore("code", 1).
+		AddScore("id", 23).
+		AddNumber(1).
+		AddNumber(2)
+	got, err := b.Build()
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	want := DemoData{
+		Names:   []string{"Raghu", "Mahesh"},
+		Scores:  map[string]int{"code": 1, "id": 23},
+		Numbers: []int{1, 2},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Build = %+v, want %+v", got, want)
+	}
+
+	// Further builder calls must not reach into the built value.
+	b.WithName("Shilesh").AddScore("code", 99).AddNumber(3)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("builder changes leaked into built value: %+v", got)
+	}
+}
+
+func TestDemoDataBuilderRequiresName(t *testing.T) {
+	tests := []struct {
+		name string
+		b    *DemoDataBuilder
+	}{
+		{"empty builder", new(DemoDataBuilder)},
+		{"scores and numbers only", new(DemoDataBuilder).AddScore("code", 1).AddNumber(7)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.b.Build()
+			if err == nil {
+				t.Fatalf("Build = %+v, want error", got)
+			}
+			if !reflect.DeepEqual(got, DemoData{}) {
+				t.Errorf("Build returned %+v with its error, want zero DemoData", got)
+			}
+		})
+	}
+}
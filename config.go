@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// Config controls how the demos run.
+type Config struct {
+	Verbose    bool
+	Iterations int
+	Name       string
+	// Level is the lowest level that logf writes. Verbose lowers it to
+	// Debug regardless of DEMO_LEVEL.
+	Level Level
+	// Style selects a greeter.NewGreeter style for the functions demo.
+	// It is set from the -style flag; empty means greeter.Greet.
+	Style string
+}
+
+// DefaultConfig returns the configuration used when no environment
+// variables are set.
+func DefaultConfig() Config {
+	return Config{
+		Verbose:    false,
+		Iterations: 10,
+		Name:       "Srikanth",
+		Level:      Info,
+	}
+}
+
+// LoadConfig builds a Config from DEMO_VERBOSE, DEMO_ITERATIONS,
+// DEMO_NAME and DEMO_LEVEL, falling back to DefaultConfig for any that
+// are unset or empty. It returns an error if a set value cannot be
+// parsed.
+func LoadConfig() (Config, error) {
+	cfg := DefaultConfig()
+	if v := os.Getenv("DEMO_VERBOSE"); v != "" {
+		verbose, err := strconv.ParseBool(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("config: DEMO_VERBOSE=%q is not a boolean", v)
+		}
+		cfg.Verbose = verbose
+	}
+	if v := os.Getenv("DEMO_ITERATIONS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return Config{}, fmt.Errorf("config: DEMO_ITERATIONS=%q is not a non-negative integer", v)
+		}
+		cfg.Iterations = n
+	}
+	if v := os.Getenv("DEMO_NAME"); v != "" {
+		cfg.Name = v
+	}
+	if v := os.Getenv("DEMO_LEVEL"); v != "" {
+		level, err := ParseLevel(v)
+		if err != nil {
+			return Config{}, fmt.Errorf("config: DEMO_LEVEL: %w", err)
+		}
+		cfg.Level = level
+	}
+	if cfg.Verbose {
+		cfg.Level = Debug
+	}
+	return cfg, nil
+}
@@ -0,0 +1,58 @@
+package main
+
+import "testing"
+
+// clearDemoEnv blanks every DEMO_* variable LoadConfig reads, so a test
+// only sees the values it sets itself.
+func clearDemoEnv(t *testing.T) {
+	t.Helper()
+	for _, key := range []string{"DEMO_VERBOSE", "DEMO_ITERATIONS", "DEMO_NAME", "DEMO_LEVEL"} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestLoadConfigDefaults(t *testing.T) {
+	clearDemoEnv(t)
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if cfg != DefaultConfig() {
+		t.Errorf("LoadConfig = %+v, want %+v", cfg, DefaultConfig())
+	}
+}
+
+func TestLoadConfigOverrides(t *testing.T) {
+	clearDemoEnv(t)
+	t.Setenv("DEMO_VERBOSE", "true")
+	t.Setenv("DEMO_ITERATIONS", "3")
+	t.Setenv("DEMO_NAME", "Ravi")
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	if !cfg.Verbose || cfg.Iterations != 3 || cfg.Name != "Ravi" {
+		t.Errorf("LoadConfig = %+v, want Verbose, 3 iterations, name Ravi", cfg)
+	}
+}
+
+func TestLoadConfigErrors(t *testing.T) {
+	tests := []struct {
+		key, value string
+	}{
+		{"DEMO_ITERATIONS", "ten"},
+		{"DEMO_ITERATIONS", "-1"},
+		{"DEMO_ITERATIONS", "1.5"},
+		{"DEMO_VERBOSE", "maybe"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key+"="+tt.value, func(t *testing.T) {
+			clearDemoEnv(t)
+			t.Setenv(tt.key, tt.value)
+			if cfg, err := LoadConfig(); err == nil {
+				t.Errorf("LoadConfig = %+v, want error", cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// FloatToInt converts f to an int the same way int(f) does, truncating
+// towards zero. The bool reports whether a fractional part was lost.
+// The result is unspecified for NaN, infinities and values outside the
+// int range; use FloatToIntChecked when the input is not trusted.
+func FloatToInt(f float64) (int, bool) {
+	n := int(f)
+	return n, float64(n) != f
+}
+
+// FloatToIntChecked converts f to an int, truncating towards zero. It
+// returns an error for NaN, infinities and values that do not fit in
+// an int.
+func FloatToIntChecked(f float64) (int, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, fmt.Errorf("convert: cannot convert %v to int", f)
+	}
+	// math.MinInt is a power of two and therefore exact as a float64,
+	// unlike math.MaxInt, so use it to bound both ends of the range.
+	if f < float64(math.MinInt) || f >= -float64(math.MinInt) {
+		return 0, fmt.Errorf("convert: %v overflows int", f)
+	}
+	return int(f), nil
+}
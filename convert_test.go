@@ -0,0 +1,55 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloatToInt(t *testing.T) {
+	tests := []struct {
+		in            float64
+		want          int
+		wantTruncated bool
+	}{
+		{45.89, 45, true},
+		{-45.89, -45, true},
+		{45, 45, false},
+		{0, 0, false},
+		{-0.5, 0, true},
+	}
+	for _, tt := range tests {
+		got, truncated := FloatToInt(tt.in)
+		if got != tt.want || truncated != tt.wantTruncated {
+			t.Errorf("FloatToInt(%v) = %d, %t, want %d, %t", tt.in, got, truncated, tt.want, tt.wantTruncated)
+		}
+	}
+}
+
+func TestFloatToIntChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      float64
+		want    int
+		wantErr bool
+	}{
+		{"fraction", 45.89, 45, false},
+		{"negative", -2.5, -2, false},
+		{"min int", float64(math.MinInt), math.MinInt, false},
+		{"NaN", math.NaN(), 0, true},
+		{"+Inf", math.Inf(1), 0, true},
+		{"-Inf", math.Inf(-1), 0, true},
+		{"above max", -float64(math.MinInt), 0, true},
+		{"below min", float64(math.MinInt) * 2, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FloatToIntChecked(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FloatToIntChecked(%v) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FloatToIntChecked(%v) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CSV layout used by WriteRecords and ReadRecords. The first row is the
+// header below and every following row is one DemoData:
+//
+//	names,scores,numbers
+//	Raghu;Mahesh,code=1;id=23,1;2;3
+//
+// List items are separated by ';' and scores are written as key=value in
+// ascending key order. An empty field decodes to a nil slice or map.
+var csvHeader = []string{"names", "scores", "numbers"}
+
+const csvListSep = ";"
+
+// WriteRecords writes records to w as CSV, header first. It returns an
+// error if a name is empty, since an empty name cannot be told apart
+// from a missing one in the list column, or if a name or score key
+// contains a character reserved by the layout.
+func WriteRecords(w io.Writer, records []DemoData) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return fmt.Errorf("write records: %w", err)
+	}
+	for i, d := range records {
+		row, err := encodeRecord(d)
+		if err != nil {
+			return fmt.Errorf("write records: record %d: %w", i, err)
+		}
+		if err := cw.Write(row); err != nil {
+			return fmt.Errorf("write records: %w", err)
+		}
+	}
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("write records: %w", err)
+	}
+	return nil
+}
+
+// ReadRecords reads CSV written by WriteRecords. It returns an error if
+// the header does not match, a row has the wrong number of columns or a
+// field cannot be decoded.
+func ReadRecords(r io.Reader) ([]DemoData, error) {
+	cr := csv.NewReader(r)
+	// Column counts are checked below so the error can name the line.
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("read records: missing header row")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read records: %w", err)
+	}
+	if strings.Join(header, ",") != strings.Join(csvHeader, ",") {
+		return nil, fmt.Errorf("read records: header %q, want %q", header, csvHeader)
+	}
+
+	records := []DemoData{}
+	for {
+		row, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read records: %w", err)
+		}
+		line, _ := cr.FieldPos(0)
+		if len(row) != len(csvHeader) {
+			return nil, fmt.Errorf("read records: line %d: got %d columns, want %d", line, len(row), len(csvHeader))
+		}
+		d, err := decodeRecord(row)
+		if err != nil {
+			return nil, fmt.Errorf("read records: line %d: %w", line, err)
+		}
+		records = append(records, d)
+	}
+}
+
+func encodeRecord(d DemoData) ([]string, error) {
+	for _, name := range d.Names {
+		if name == "" {
+			return nil, errors.New("name must not be empty")
+		}
+		if strings.Contains(name, csvListSep) {
+			return nil, fmt.Errorf("name %q contains %q", name, csvListSep)
+		}
+	}
+	scores := make([]string, 0, len(d.Scores))
+	for _, key := range SortedKeys(d.Scores) {
+		if strings.ContainsAny(key, csvListSep+"=") {
+			return nil, fmt.Errorf("score key %q contains %q or %q", key, csvListSep, "=")
+		}
+		scores = append(scores, key+"="+strconv.Itoa(d.Scores[key]))
+	}
+	numbers := Map(d.Numbers, strconv.Itoa)
+	return []string{
+		strings.Join(d.Names, csvListSep),
+		strings.Join(scores, csvListSep),
+		strings.Join(numbers, csvListSep),
+	}, nil
+}
+
+func decodeRecord(row []string) (DemoData, error) {
+	var d DemoData
+	if row[0] != "" {
+		d.Names = strings.Split(row[0], csvListSep)
+	}
+	if row[1] != "" {
+		d.Scores = make(map[string]int)
+		for _, item := range strings.Split(row[1], csvListSep) {
+			key, value, ok := strings.Cut(item, "=")
+			if !ok {
+				return DemoData{}, fmt.Errorf("score %q is not key=value", item)
+			}
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return DemoData{}, fmt.Errorf("score %q has a non-integer value", item)
+			}
+			d.Scores[key] = n
+		}
+	}
+	if row[2] != "" {
+		for _, item := range strings.Split(row[2], csvListSep) {
+			n, err := strconv.Atoi(item)
+			if err != nil {
+				return DemoData{}, fmt.Errorf("number %q is not an integer", item)
+			}
+			d.Numbers = append(d.Numbers, n)
+		}
+	}
+	return d, nil
+}
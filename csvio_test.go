@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRecordsRoundTrip(t *testing.T) {
+	records := []DemoData{
+		{
+			Names:   []string{"Raghu", "Mahesh"},
+			Scores:  map[string]int{"id": 23, "code": 1},
+			Numbers: []int{1, -2, 3},
+		},
+		{Names: []string{"Srikanth, Jr."}},
+		{},
+	}
+	var buf bytes.Buffer
+	if err := WriteRecords(&buf, records); err != nil {
+		t.Fatalf("WriteRecords: %v", err)
+	}
+	want := "names,scores,numbers\n" +
+		"Raghu;Mahesh,code=1;id=23,1;-2;3\n" +
+		"\"Srikanth, Jr.\",,\n" +
+		",,\n"
+	if got := buf.String(); got != want {
+		t.Errorf("WriteRecords output:\n%s\nwant:\n%s", got, want)
+	}
+
+	got, err := ReadRecords(&buf)
+	if err != nil {
+		t.Fatalf("ReadRecords: %v", err)
+	}
+	if !reflect.DeepEqual(got, records) {
+		t.Errorf("round trip = %+v, want %+v", got, records)
+	}
+}
+
+func TestReadRecordsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantMsg string
+	}{
+		{"missing header", "", "missing header"},
+		{"wrong header", "a,b,c\n", "header"},
+		{"too few columns", "names,scores,numbers\nRaghu,code=1\n", "line 2: got 2 columns, want 3"},
+		{"too many columns", "names,scores,numbers\nRaghu,,1,extra\n", "line 2: got 4 columns, want 3"},
+		{"bad score", "names,scores,numbers\nRaghu,code,\n", "line 2"},
+		{"bad score value", "names,scores,numbers\nRaghu,code=x,\n", "line 2"},
+		{"bad number", "names,scores,numbers\nRaghu,,1;two\n", "line 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ReadRecords(strings.NewReader(tt.in))
+			if err == nil {
+				t.Fatal("ReadRecords succeeded, want error")
+			}
+			if !strings.Contains(err.Error(), tt.wantMsg) {
+				t.Errorf("ReadRecords error %q does not contain %q", err, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestWriteRecordsRejectsUnencodable(t *testing.T) {
+	tests := []struct {
+		name string
+		d    DemoData
+	}{
+		{"empty name", DemoData{Names: []string{""}}},
+		{"name with separator", DemoData{Names: []string{"a;b"}}},
+		{"score key with separator", DemoData{Names: []string{"a"}, Scores: map[string]int{"x;y": 1}}},
+		{"score key with equals", DemoData{Names: []string{"a"}, Scores: map[string]int{"x=y": 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := WriteRecords(&bytes.Buffer{}, []DemoData{tt.d}); err == nil {
+				t.Errorf("WriteRecords(%+v) succeeded, want error", tt.d)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// Debounce returns a trigger that schedules fn to run once d has passed
+// without another call to trigger, and a cancel that drops any pending
+// run. Calling trigger after cancel starts a new quiet period. Both are
+// safe to call from multiple goroutines; fn runs on its own goroutine.
+func Debounce(d time.Duration, fn func()) (trigger func(), cancel func()) {
+	var mu sync.Mutex
+	var timer *time.Timer
+
+	trigger = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(d, fn)
+	}
+	cancel = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+	}
+	return trigger, cancel
+}
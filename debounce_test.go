@@ -0,0 +1,56 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDebounceRunsOnceAfterQuietPeriod(t *testing.T) {
+	var runs atomic.Int32
+	trigger, cancel := Debounce(50*time.Millisecond, func() { runs.Add(1) })
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				trigger()
+				time.Sleep(time.Millisecond)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := runs.Load(); n != 0 {
+		t.Fatalf("fn ran %d times during the burst, want 0", n)
+	}
+
+	time.Sleep(150 * time.Millisecond)
+	if n := runs.Load(); n != 1 {
+		t.Errorf("fn ran %d times after the quiet period, want 1", n)
+	}
+}
+
+func TestDebounceCancel(t *testing.T) {
+	var runs atomic.Int32
+	trigger, cancel := Debounce(20*time.Millisecond, func() { runs.Add(1) })
+	trigger()
+	trigger()
+	cancel()
+	time.Sleep(60 * time.Millisecond)
+	if n := runs.Load(); n != 0 {
+		t.Errorf("fn ran %d times after cancel, want 0", n)
+	}
+
+	// A trigger after cancel starts a fresh quiet period.
+	trigger()
+	time.Sleep(60 * time.Millisecond)
+	if n := runs.Load(); n != 1 {
+		t.Errorf("fn ran %d times after re-triggering, want 1", n)
+	}
+	cancel()
+	cancel()
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode"
+)
+
+var (
+	errDivisionByZero   = errors.New("division by zero")
+	errUnbalancedParens = errors.New("unbalanced parentheses")
+	errUnexpectedToken  = errors.New("unexpected token")
+	errUnexpectedEnd    = errors.New("unexpected end of expression")
+)
+
+// Eval evaluates an integer arithmetic expression such as "(2+3)*4". It
+// supports + - * / with the usual precedence, unary minus and
+// parentheses; division truncates towards zero. Errors wrap one of the
+// package's division-by-zero, unbalanced-parentheses, unexpected-token or
+// unexpected-end errors and give the byte offset of the problem.
+func Eval(expr string) (int, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return 0, err
+	}
+	p := &parser{tokens: tokens}
+	v, err := p.expr()
+	if err != nil {
+		return 0, err
+	}
+	if t, ok := p.peek(); ok {
+		if t.text == ")" {
+			return 0, fmt.Errorf("eval: %w: stray ')' at offset %d", errUnbalancedParens, t.pos)
+		}
+		return 0, fmt.Errorf("eval: %w %q at offset %d", errUnexpectedToken, t.text, t.pos)
+	}
+	return v, nil
+}
+
+type token struct {
+	text string
+	pos  int
+}
+
+func tokenize(expr string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(expr); {
+		c := rune(expr[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case isDigit(expr[i]):
+			start := i
+			for i < len(expr) && isDigit(expr[i]) {
+				i++
+			}
+			tokens = append(tokens, token{text: expr[start:i], pos: start})
+		case c == '+' || c == '-' || c == '*' || c == '/' || c == '(' || c == ')':
+			tokens = append(tokens, token{text: string(c), pos: i})
+			i++
+		default:
+			return nil, fmt.Errorf("eval: %w %q at offset %d", errUnexpectedToken, expr[i:i+1], i)
+		}
+	}
+	return tokens, nil
+}
+
+// parser is a recursive-descent parser over the grammar
+//
+//	expr   = term { ("+" | "-") term }
+//	term   = factor { ("*" | "/") factor }
+//	factor = number | "-" factor | "(" expr ")"
+type parser struct {
+	tokens []token
+	next   int
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.next >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.next], true
+}
+
+func (p *parser) expr() (int, error) {
+	left, err := p.term()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		t, ok := p.peek()
+		if !ok || (t.text != "+" && t.text != "-") {
+			return left, nil
+		}
+		p.next++
+		right, err := p.term()
+		if err != nil {
+			return 0, err
+		}
+		if t.text == "+" {
+			left += right
+		} else {
+			left -= right
+		}
+	}
+}
+
+func (p *parser) term() (int, error) {
+	left, err := p.factor()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		t, ok := p.peek()
+		if !ok || (t.text != "*" && t.text != "/") {
+			return left, nil
+		}
+		p.next++
+		right, err := p.factor()
+		if err != nil {
+			return 0, err
+		}
+		if t.text == "*" {
+			left *= right
+			continue
+		}
+		if right == 0 {
+			return 0, fmt.Errorf("eval: %w at offset %d", errDivisionByZero, t.pos)
+		}
+		left /= right
+	}
+}
+
+func (p *parser) factor() (int, error) {
+	t, ok := p.peek()
+	if !ok {
+		return 0, fmt.Errorf("eval: %w", errUnexpectedEnd)
+	}
+	p.next++
+	switch {
+	case t.text == "-":
+		// Parse a negated literal as one number so the most negative int,
+		// whose magnitude does not fit in an int, can still be written.
+		if lit, ok := p.peek(); ok && isDigit(lit.text[0]) {
+			p.next++
+			return parseNumber("-"+lit.text, t.pos)
+		}
+		v, err := p.factor()
+		return -v, err
+	case t.text == "(":
+		v, err := p.expr()
+		if err != nil {
+			return 0, err
+		}
+		closing, ok := p.peek()
+		if !ok {
+			return 0, fmt.Errorf("eval: %w: '(' at offset %d is never closed", errUnbalancedParens, t.pos)
+		}
+		if closing.text != ")" {
+			return 0, fmt.Errorf("eval: %w %q at offset %d", errUnexpectedToken, closing.text, closing.pos)
+		}
+		p.next++
+		return v, nil
+	case isDigit(t.text[0]):
+		return parseNumber(t.text, t.pos)
+	default:
+		return 0, fmt.Errorf("eval: %w %q at offset %d", errUnexpectedToken, t.text, t.pos)
+	}
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func parseNumber(text string, pos int) (int, error) {
+	n, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("eval: number %q at offset %d out of range", text, pos)
+	}
+	return n, nil
+}
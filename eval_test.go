@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		expr string
+		want int
+	}{
+		{"2+3*4", 14},
+		{"(2+3)*4", 20},
+		{"2*3+4", 10},
+		{"10-4-3", 3},
+		{"100/10/5", 2},
+		{"7/2", 3},
+		{"-7/2", -3},
+		{"-(2+3)", -5},
+		{"--4", 4},
+		{"2*-3", -6},
+		{" ( 1 + 2 ) * ( 3 + 4 ) ", 21},
+		{"((((5))))", 5},
+		{"42", 42},
+		{strconv.Itoa(math.MaxInt), math.MaxInt},
+		{strconv.Itoa(math.MinInt), math.MinInt},
+	}
+	for _, tt := range tests {
+		got, err := Eval(tt.expr)
+		if err != nil {
+			t.Errorf("Eval(%q): %v", tt.expr, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Eval(%q) = %d, want %d", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	tests := []struct {
+		expr string
+		want error
+	}{
+		{"1/0", errDivisionByZero},
+		{"4/(2-2)", errDivisionByZero},
+		{"(1+2", errUnbalancedParens},
+		{"((1)", errUnbalancedParens},
+		{"1+2)", errUnbalancedParens},
+		{"2 $ 3", errUnexpectedToken},
+		{"2 3", errUnexpectedToken},
+		{"()", errUnexpectedToken},
+		{"*2", errUnexpectedToken},
+		{"(1 2)", errUnexpectedToken},
+		{"", errUnexpectedEnd},
+		{"2+", errUnexpectedEnd},
+	}
+	for _, tt := range tests {
+		_, err := Eval(tt.expr)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("Eval(%q) error = %v, want %v", tt.expr, err, tt.want)
+		}
+	}
+}
+
+func TestEvalErrorMessagesDistinct(t *testing.T) {
+	sentinels := []error{errDivisionByZero, errUnbalancedParens, errUnexpectedToken, errUnexpectedEnd}
+	seen := map[string]bool{}
+	for _, err := range sentinels {
+		if seen[err.Error()] {
+			t.Errorf("error message %q is not distinct", err)
+		}
+		seen[err.Error()] = true
+	}
+}
+
+func TestEvalNumberOutOfRange(t *testing.T) {
+	if _, err := Eval("99999999999999999999"); err == nil {
+		t.Error("Eval of an out-of-range literal succeeded, want error")
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"sync"
+)
+
+// Merge forwards every value received on chans to the returned channel,
+// in no particular order. The returned channel is closed once all inputs
+// are closed and drained, or as soon as possible after ctx is cancelled,
+// in which case any values not yet forwarded are dropped.
+func Merge(ctx context.Context, chans ...<-chan int) <-chan int {
+	out := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(len(chans))
+	for _, ch := range chans {
+		go func(ch <-chan int) {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case v, ok := <-ch:
+					if !ok {
+						return
+					}
+					select {
+					case out <- v:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}(ch)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMergeDeliversAll(t *testing.T) {
+	ctx := context.Background()
+	out := Merge(ctx, generate(1, 2, 3), generate(10, 20), generate(100, 200, 300, 400))
+
+	var got []int
+	for v := range out {
+		got = append(got, v)
+	}
+	slices.Sort(got)
+	want := []int{1, 2, 3, 10, 20, 100, 200, 300, 400}
+	if !slices.Equal(got, want) {
+		t.Errorf("Merge delivered %v, want %v in any order", got, want)
+	}
+}
+
+func TestMergeNoInputs(t *testing.T) {
+	select {
+	case _, ok := <-Merge(context.Background()):
+		if ok {
+			t.Error("Merge with no inputs produced a value")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Merge with no inputs did not close its output")
+	}
+}
+
+func TestMergeCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// These inputs never close, so only cancellation can end the merge.
+	idle := make(chan int)
+	endless := make(chan int)
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for i := 0; ; i++ {
+			select {
+			case endless <- i:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	out := Merge(ctx, idle, endless)
+	for i := 0; i < 5; i++ {
+		<-out
+	}
+	cancel()
+
+	deadline := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-out:
+			if !ok {
+				return
+			}
+		case <-deadline:
+			t.Fatal("Merge output not closed after cancel")
+		}
+	}
+}
@@ -1,15 +1,53 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
+	"math"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/raghu9189/go-lang-docs/greeter"
 )
 
-func callMeFun(paraName string) int {
-	myNameString := paraName
-	fmt.Println("Called Me!", paraName, myNameString)
-	return 45
+var myNames = []string{"Raghu", "Mahesh", "Shilesh"}
+
+type demo struct {
+	name string
+	run  func(out io.Writer, cfg Config) error
 }
-func main() {
+
+var demos = []demo{
+	{"variables", runVariablesDemo},
+	{"loops", runLoopsDemo},
+	{"slices", runSlicesDemo},
+	{"functions", runFunctionsDemo},
+	{"maps", runMapsDemo},
+	{"json", runJSONDemo},
+	{"concurrency", runConcurrencyDemo},
+	{"words", runWordsDemo},
+	{"turnstile", runTurnstileDemo},
+	{"eval", runEvalDemo},
+}
+
+func demoNames() []string {
+	names := make([]string, 0, len(demos)+1)
+	for _, d := range demos {
+		names = append(names, d.name)
+	}
+	return append(names, "all")
+}
+
+func runVariablesDemo(out io.Writer, cfg Config) error {
 	var myBool bool = true
 	var myInteger uint = 2345
 	var myFloat float32 = 34250.9800
@@ -17,36 +55,451 @@ func main() {
 	var myArray = [...]int{1, 2, 3}
 	mySlice := []int{}
 	mySlice = append(mySlice, 20, 23)
-	fmt.Print(myBool, "\n", myInteger, myFloat, myName, myArray, mySlice)
-	myNames := []string{"Raghu", "Mahesh", "Shilesh"}
+	fmt.Fprint(out, myBool, "\n", myInteger, myFloat, myName, myArray, mySlice)
+	fmt.Fprintln(out)
+
+	avg, _ := Average(myArray[:])
+	low, high, _ := MinMax(myArray[:])
+	fmt.Fprintln(out, Sum(myArray[:]), avg, low, high)
+
+	var exMyFloat float32 = 45.89
+
+	truncatedInt, lostFraction := FloatToInt(float64(exMyFloat))
+	fmt.Fprintln(out, truncatedInt, lostFraction)
+	if _, err := FloatToIntChecked(math.Inf(1)); err != nil {
+		fmt.Fprintln(out, err)
+	}
 
+	for _, text := range []string{"42", " 0x2A ", "0o52", "-0b101010", "12abc"} {
+		n, err := ParseIntFlexible(text)
+		if err != nil {
+			fmt.Fprintln(out, err)
+			continue
+		}
+		fmt.Fprintln(out, n)
+	}
+	return nil
+}
+
+func runLoopsDemo(out io.Writer, cfg Config) error {
 	for index, value := range myNames {
-		fmt.Println(index, value)
+		fmt.Fprintln(out, index, value)
 	}
-	for i := 0; i < 10; i++ {
-		fmt.Println(i)
+	for _, i := range Range(0, cfg.Iterations, 1) {
+		fmt.Fprintln(out, i)
 	}
 
 	for i := 0; i < len(myNames); i++ {
-		fmt.Println(myNames[i])
+		fmt.Fprintln(out, myNames[i])
+	}
+
+	for i := 0; i < 10; i++ {
+		fib, err := Fib(i)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, fib, " ")
+	}
+	fmt.Fprintln(out)
+
+	naiveCalls, memoCalls := 0, 0
+	var naiveFib func(n int) uint64
+	naiveFib = func(n int) uint64 {
+		naiveCalls++
+		if n < 2 {
+			return uint64(n)
+		}
+		return naiveFib(n-1) + naiveFib(n-2)
+	}
+	var memoFib func(n int) uint64
+	memoFib = Memoize(func(n int) uint64 {
+		memoCalls++
+		if n < 2 {
+			return uint64(n)
+		}
+		return memoFib(n-1) + memoFib(n-2)
+	})
+	fmt.Fprintln(out, naiveFib(25), naiveCalls, memoFib(25), memoCalls)
+
+	str := "Hello"
+	for index, runeValue := range str {
+		fmt.Fprintf(out, "Index: %d, Rune: %c\n", index, runeValue)
+	}
+	return nil
+}
+
+func runSlicesDemo(out io.Writer, cfg Config) error {
+	mySlice := []int{20, 23}
+	nameLengths := Map(myNames, func(name string) int { return len(name) })
+	evens := Filter([]int{1, 2, 3, 4, 5, 6}, func(n int) bool { return n%2 == 0 })
+	total := Reduce(mySlice, 0, func(acc, n int) int { return acc + n })
+	fmt.Fprintln(out, nameLengths, evens, total)
+	evenNums, oddNums := Partition([]int{1, 2, 3, 4, 5, 6}, func(n int) bool { return n%2 == 0 })
+	fmt.Fprintln(out, evenNums, oddNums)
+
+	aliased := mySlice
+	cloned := CloneSlice(mySlice)
+	cloned[0] = 99
+	aliased[1] = 42
+	fmt.Fprintln(out, mySlice, cloned)
+	fmt.Fprintln(out, SliceEqual(mySlice, CloneSlice(mySlice)), SliceEqual(mySlice, cloned), SliceEqual([]int(nil), []int{}))
+	fmt.Fprintln(out, Contains(myNames, "Mahesh"), IndexOf(myNames, "Mahesh"))
+	fmt.Fprintln(out, Reversed(myNames), myNames)
+	byLength := func(a, b string) bool { return len(a) < len(b) }
+	longest, _ := MaxBy(myNames, byLength)
+	shortest, _ := MinBy(myNames, byLength)
+	fmt.Fprintln(out, longest, shortest)
+	fmt.Fprintln(out, Chunk([]int{1, 2, 3, 4, 5, 6, 7}, 3))
+	fmt.Fprintln(out, Dedup([]int{3, 1, 3, 2, 1, 3}))
+	fmt.Fprintln(out, Range(10, 0, -3), Repeat("go", 3))
+	sorted := []int{1, 3, 3, 5, 8}
+	i, found := BinarySearch(sorted, 3)
+	j, missing := BinarySearch(sorted, 4)
+	fmt.Fprintln(out, i, found, j, missing)
+
+	scores := []int{90, 75, 82}
+	for _, p := range Zip(myNames, scores) {
+		fmt.Fprintf(out, "%s=%d ", p.First, p.Second)
+	}
+	fmt.Fprintln(out)
+	byScore := SortedBy(Zip(myNames, scores), func(p Pair[string, int]) int { return -p.Second })
+	fmt.Fprintln(out, byScore)
+
+	grid := Matrix{{1, 2, 3}, {4, 5, 6}}
+	doubled, err := grid.Add(grid)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, grid.Transpose(), doubled)
+	fmt.Fprintln(out, Flatten(grid), Flatten([][]int{{1}, nil, {2, 3}}))
+
+	var stack Stack[int]
+	stack.Push(20)
+	stack.Push(23)
+	for stack.Len() > 0 {
+		top, _ := stack.Pop()
+		fmt.Fprint(out, top, " ")
+	}
+	fmt.Fprintln(out)
+
+	var queue Queue[string]
+	for _, name := range myNames {
+		queue.Enqueue(name)
+	}
+	for queue.Len() > 0 {
+		front, _ := queue.Dequeue()
+		fmt.Fprint(out, front, " ")
+	}
+	fmt.Fprintln(out)
+	return nil
+}
+
+func runFunctionsDemo(out io.Writer, cfg Config) error {
+	greet := greeter.Greet
+	if cfg.Style != "" {
+		g, err := greeter.NewGreeter(cfg.Style)
+		if err != nil {
+			return err
+		}
+		greet = g.Greet
 	}
-	var myNumReturnded int = callMeFun("Srikanth")
-	fmt.Println(myNumReturnded)
+	greeting, err := greet(cfg.Name)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, greeting)
+
+	if _, err := greeter.Greet("   "); errors.Is(err, greeter.ErrEmptyName) {
+		fmt.Fprintln(out, "rejected:", err)
+	}
+
+	calls := 0
+	flaky := func() error {
+		calls++
+		if calls < 3 {
+			logf(out, cfg, Debug, "attempt %d failed", calls)
+			return fmt.Errorf("attempt %d failed", calls)
+		}
+		return nil
+	}
+	if err := Retry(context.Background(), 5, 10*time.Millisecond, flaky); err != nil {
+		return fmt.Errorf("retry: %w", err)
+	}
+	logf(out, cfg, Info, "succeeded after %d calls", calls)
+	return nil
+}
 
+func runMapsDemo(out io.Writer, cfg Config) error {
 	myMap := map[string]int{}
 	myMap["code"] = 1
 	myMap["id"] = 23
 
-	for id, value := range myMap {
-		println(id, value)
+	SortedRange(myMap, func(id string, value int) {
+		fmt.Fprintln(out, id, value)
+	})
+	fmt.Fprintln(out, len(Keys(myMap)), Reduce(Values(myMap), 0, func(acc, v int) int { return acc + v }))
+	fmt.Fprintln(out, MapEqual(myMap, CloneMap(myMap)))
+
+	rows := [][]string{}
+	SortedRange(myMap, func(id string, value int) {
+		rows = append(rows, []string{id, strconv.Itoa(value)})
+	})
+	PrintTable(out, []string{"key", "value"}, rows)
+
+	double := func(v int) int { return v * 2 }
+	fmt.Fprintln(out, Lookup(myMap, "id").Map(double).OrElse(-1), Lookup(myMap, "missing").Map(double).OrElse(-1))
+
+	people := []string{"Raghu", "Mahesh", "Shilesh", "Srikanth", "Ramesh"}
+	byInitial := GroupBy(people, func(name string) byte { return name[0] })
+	SortedRange(byInitial, func(initial byte, names []string) {
+		fmt.Fprintf(out, "%c: %v\n", initial, names)
+	})
+
+	team := NewSet(myNames...)
+	visitors := NewSet("Mahesh", "Srikanth")
+	fmt.Fprintln(out, SortedSlice(team.Union(visitors)), SortedSlice(team.Intersect(visitors)))
+	return nil
+}
+
+func runJSONDemo(out io.Writer, cfg Config) error {
+	data := DemoData{
+		Names:   myNames,
+		Scores:  map[string]int{"code": 1, "id": 23},
+		Numbers: []int{1, 2, 3},
+	}
+	b, err := data.ToJSON()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, string(b))
+
+	decoded, err := FromJSON(b)
+	if err != nil {
+		return err
 	}
+	fmt.Fprintln(out, decoded.Names, decoded.Scores, decoded.Numbers)
 
-	var exMyFloat float32 = 45.89
+	var csvBuf bytes.Buffer
+	if err := WriteRecords(&csvBuf, []DemoData{data, {Names: []string{"Srikanth"}}}); err != nil {
+		return err
+	}
+	fmt.Fprint(out, csvBuf.String())
+	records, err := ReadRecords(&csvBuf)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, len(records), records[1].Names)
 
-	println(int(exMyFloat))
-	str := "Hello"
-	for index, runeValue := range str {
-		fmt.Printf("Index: %d, Rune: %c\n", index, runeValue)
+	built, err := new(DemoDataBuilder).
+		WithName("Raghu").
+		WithName("Mahesh").
+		AddScore("code", 1).
+		AddNumber(7).
+		Build()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, built.Names, built.Scores, built.Numbers)
+	if _, err := new(DemoDataBuilder).AddNumber(1).Build(); err != nil {
+		fmt.Fprintln(out, err)
+	}
+	return nil
+}
+
+func runConcurrencyDemo(out io.Writer, cfg Config) error {
+	squares, err := SquareAll(context.Background(), []int{1, 2, 3, 4, 5}, 3)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(out, squares)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := NewStore(ctx)
+	store.Set("code", 1, 0)
+	store.Set("session", 23, 20*time.Millisecond)
+	time.Sleep(30 * time.Millisecond)
+	code, codeOK := store.Get("code")
+	_, sessionOK := store.Get("session")
+	fmt.Fprintln(out, code, codeOK, sessionOK)
+
+	merged := 0
+	for v := range Merge(ctx, generate(1, 2, 3), generate(10, 20), generate(100)) {
+		merged += v
+	}
+	fmt.Fprintln(out, "merged sum:", merged)
+
+	limiter := NewLimiter(50)
+	defer limiter.Stop()
+	start := time.Now()
+	for i := 0; i < 60; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(out, "60 calls at 50/s took about", time.Since(start).Round(100*time.Millisecond))
+
+	slowSquare := func(n int, delay time.Duration) func(context.Context) (int, error) {
+		return func(ctx context.Context) (int, error) {
+			select {
+			case <-time.After(delay):
+				return n * n, nil
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		}
+	}
+	fast, err := RunWithTimeout(ctx, 50*time.Millisecond, slowSquare(7, time.Millisecond))
+	fmt.Fprintln(out, fast, err)
+	slow, err := RunWithTimeout(ctx, 10*time.Millisecond, slowSquare(7, time.Second))
+	fmt.Fprintln(out, slow, err)
+
+	var saves atomic.Int32
+	save, cancelSave := Debounce(20*time.Millisecond, func() { saves.Add(1) })
+	defer cancelSave()
+	for i := 0; i < 5; i++ {
+		save()
+	}
+	time.Sleep(50 * time.Millisecond)
+	fmt.Fprintln(out, "5 triggers, saves:", saves.Load())
+	return nil
+}
+
+func runWordsDemo(out io.Writer, cfg Config) error {
+	text := "Go is simple. Go is fast! Is Go fun? Yes, go is fun."
+	for _, wc := range TopN(WordFrequency(text), 3) {
+		fmt.Fprintln(out, wc.Word, wc.Count)
+	}
+	return nil
+}
+
+func runTurnstileDemo(out io.Writer, cfg Config) error {
+	var t Turnstile
+	fmt.Fprintln(out, "push:", t.Push())
+	fmt.Fprintln(out, "coin:", t.Coin())
+	fmt.Fprintln(out, "coin:", t.Coin())
+	fmt.Fprintln(out, "push:", t.Push())
+	return nil
+}
+
+func runEvalDemo(out io.Writer, cfg Config) error {
+	for _, expr := range []string{"2+3*4", "(2+3)*4", "-(7-10)/2", "1/0", "(1+2", "1+2)", "2 $ 3"} {
+		v, err := Eval(expr)
+		if err != nil {
+			fmt.Fprintln(out, err)
+			continue
+		}
+		fmt.Fprintf(out, "%s = %d\n", expr, v)
+	}
+	return nil
+}
+
+// generate returns a channel that yields nums and is then closed.
+func generate(nums ...int) <-chan int {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for _, n := range nums {
+			ch <- n
+		}
+	}()
+	return ch
+}
+
+func runStdin() {
+	nums, err := ReadInts(os.Stdin)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	avg, err := Average(nums)
+	if err != nil {
+		fmt.Println("no numbers read")
+		return
+	}
+	fmt.Printf("sum: %d, average: %.2f\n", Sum(nums), avg)
+}
+
+func runCount(path string) {
+	f, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+	lines, err := CountLines(f)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Println(lines)
+}
+
+func main() {
+	demoName := flag.String("demo", "all", "demo section to run: "+strings.Join(demoNames(), ", "))
+	readStdin := flag.Bool("stdin", false, "read integers from stdin and print their sum and average")
+	serveMode := flag.Bool("serve", false, "run a long-lived demo until interrupted with SIGINT or SIGTERM")
+	countFile := flag.String("count", "", "print the number of lines in the named file")
+	style := flag.String("style", "", "greeting style for the functions demo: formal or casual")
+	flag.Parse()
+
+	if *countFile != "" {
+		runCount(*countFile)
+		return
+	}
+
+	if *serveMode {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		serve(ctx, os.Stdout, time.Second)
+		fmt.Println("shutting down")
+		return
+	}
+
+	if *readStdin {
+		runStdin()
+		return
+	}
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if *style != "" {
+		if _, err := greeter.NewGreeter(*style); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		cfg.Style = *style
 	}
 
+	if *demoName != "all" && !isDemo(*demoName) {
+		fmt.Fprintf(os.Stderr, "unknown demo %q, valid demos: %s\n", *demoName, strings.Join(demoNames(), ", "))
+		os.Exit(2)
+	}
+	var tasks []func() error
+	for _, d := range demos {
+		if *demoName == "all" || *demoName == d.name {
+			tasks = append(tasks, func() error {
+				if err := d.run(os.Stdout, cfg); err != nil {
+					return fmt.Errorf("%s demo: %w", d.name, err)
+				}
+				return nil
+			})
+		}
+	}
+	if err := RunAll(tasks...); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func isDemo(name string) bool {
+	for _, d := range demos {
+		if d.name == name {
+			return true
+		}
+	}
+	return false
 }
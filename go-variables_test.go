@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestDemosRun(t *testing.T) {
+	for _, d := range demos {
+		t.Run(d.name, func(t *testing.T) {
+			if err := d.run(io.Discard, DefaultConfig()); err != nil {
+				t.Errorf("%s demo: %v", d.name, err)
+			}
+		})
+	}
+}
+
+func TestIsDemo(t *testing.T) {
+	for _, d := range demos {
+		if !isDemo(d.name) {
+			t.Errorf("isDemo(%q) = false, want true", d.name)
+		}
+	}
+	for _, name := range []string{"all", "", "bogus"} {
+		if isDemo(name) {
+			t.Errorf("isDemo(%q) = true, want false", name)
+		}
+	}
+}
+
+func TestDemoOutput(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(io.Writer, Config) error
+		want string
+	}{
+		{"turnstile", runTurnstileDemo, "push: Locked\ncoin: Unlocked\ncoin: Unlocked\npush: Locked\n"},
+		{"words", runWordsDemo, "go 4\nis 4\nfun 2\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.run(&buf, DefaultConfig()); err != nil {
+				t.Fatalf("%s demo: %v", tt.name, err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("%s demo output:\n%s\nwant:\n%s", tt.name, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/raghu9189/go-lang-docs
+
+go 1.22
@@ -0,0 +1,73 @@
+package greeter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrEmptyName is returned, wrapped, when a name is empty or contains only
+// whitespace. Check for it with errors.Is.
+var ErrEmptyName = errors.New("name must not be empty")
+
+// Greeter produces a greeting for a name.
+type Greeter interface {
+	Greet(name string) (string, error)
+}
+
+// FormalGreeter greets with "Good day, <name>".
+type FormalGreeter struct{}
+
+// Greet implements Greeter.
+func (FormalGreeter) Greet(name string) (string, error) {
+	trimmed, err := checkName(name)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Good day, %s", trimmed), nil
+}
+
+// CasualGreeter greets with "Hey <name>!".
+type CasualGreeter struct{}
+
+// Greet implements Greeter.
+func (CasualGreeter) Greet(name string) (string, error) {
+	trimmed, err := checkName(name)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Hey %s!", trimmed), nil
+}
+
+// NewGreeter returns the Greeter for style, which is "formal" or
+// "casual".
+func NewGreeter(style string) (Greeter, error) {
+	switch style {
+	case "formal":
+		return FormalGreeter{}, nil
+	case "casual":
+		return CasualGreeter{}, nil
+	default:
+		return nil, fmt.Errorf("greeter: unknown style %q, want formal or casual", style)
+	}
+}
+
+// Greet returns a greeting for name. It returns an error wrapping
+// ErrEmptyName when name is empty or contains only whitespace.
+func Greet(name string) (string, error) {
+	trimmed, err := checkName(name)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Hello, %s!", trimmed), nil
+}
+
+// checkName returns name without surrounding whitespace, or an error
+// wrapping ErrEmptyName if nothing is left.
+func checkName(name string) (string, error) {
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" {
+		return "", fmt.Errorf("greet %q: %w", name, ErrEmptyName)
+	}
+	return trimmed, nil
+}
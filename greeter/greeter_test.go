@@ -0,0 +1,121 @@
+package greeter
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestGreet(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"plain", "Srikanth", "Hello, Srikanth!", false},
+		{"surrounding whitespace", "  Raghu\t", "Hello, Raghu!", false},
+		{"unicode", "Zoë 日本", "Hello, Zoë 日本!", false},
+		{"empty", "", "", true},
+		{"whitespace only", " \t\n ", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Greet(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Greet(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Greet(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGreetErrEmptyName(t *testing.T) {
+	for _, name := range []string{"", "   "} {
+		_, err := Greet(name)
+		if !errors.Is(err, ErrEmptyName) {
+			t.Errorf("Greet(%q) error = %v, want it to wrap ErrEmptyName", name, err)
+		}
+		if err == ErrEmptyName {
+			t.Errorf("Greet(%q) returned ErrEmptyName unwrapped, want added context", name)
+		}
+		// Wrapping again must keep the sentinel reachable.
+		if wrapped := fmt.Errorf("demo: %w", err); !errors.Is(wrapped, ErrEmptyName) {
+			t.Errorf("errors.Is lost ErrEmptyName through a second wrap: %v", wrapped)
+		}
+	}
+	if _, err := Greet("Raghu"); errors.Is(err, ErrEmptyName) {
+		t.Errorf("Greet(\"Raghu\") error = %v, want nil", err)
+	}
+}
+
+func TestGreeterStyles(t *testing.T) {
+	tests := []struct {
+		name    string
+		g       Greeter
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"formal", FormalGreeter{}, "Raghu", "Good day, Raghu", false},
+		{"formal trims", FormalGreeter{}, " Raghu\n", "Good day, Raghu", false},
+		{"formal empty", FormalGreeter{}, "  ", "", true},
+		{"casual", CasualGreeter{}, "Raghu", "Hey Raghu!", false},
+		{"casual trims", CasualGreeter{}, "\tRaghu ", "Hey Raghu!", false},
+		{"casual empty", CasualGreeter{}, "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.g.Greet(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Greet(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, ErrEmptyName) {
+				t.Errorf("Greet(%q) error = %v, want it to wrap ErrEmptyName", tt.input, err)
+			}
+			if got != tt.want {
+				t.Errorf("Greet(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewGreeter(t *testing.T) {
+	tests := []struct {
+		style string
+		want  string
+	}{
+		{"formal", "Good day, Raghu"},
+		{"casual", "Hey Raghu!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			g, err := NewGreeter(tt.style)
+			if err != nil {
+				t.Fatalf("NewGreeter(%q) error = %v", tt.style, err)
+			}
+			got, err := g.Greet("Raghu")
+			if err != nil {
+				t.Fatalf("Greet error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("NewGreeter(%q).Greet = %q, want %q", tt.style, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewGreeterUnknownStyle(t *testing.T) {
+	for _, style := range []string{"", "bogus", "Formal"} {
+		g, err := NewGreeter(style)
+		if err == nil {
+			t.Errorf("NewGreeter(%q) = %v, want error", style, g)
+			continue
+		}
+		if g != nil {
+			t.Errorf("NewGreeter(%q) returned non-nil Greeter %v with error", style, g)
+		}
+	}
+}
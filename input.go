@@ -0,0 +1,30 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ReadInts reads whitespace-separated integers from r. It stops at the
+// first token that is not an integer and reports that token together
+// with its 1-based position in the input.
+func ReadInts(r io.Reader) ([]int, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+
+	nums := []int{}
+	for pos := 1; scanner.Scan(); pos++ {
+		token := scanner.Text()
+		n, err := strconv.Atoi(token)
+		if err != nil {
+			return nil, fmt.Errorf("read ints: token %d %q is not an integer", pos, token)
+		}
+		nums = append(nums, n)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read ints: %w", err)
+	}
+	return nums, nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestReadInts(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []int
+	}{
+		{"spaces", "1 2 3", []int{1, 2, 3}},
+		{"mixed whitespace", " 10\n-4\t7 \n", []int{10, -4, 7}},
+		{"empty", "", []int{}},
+		{"only whitespace", " \n\t ", []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ReadInts(strings.NewReader(tt.in))
+			if err != nil {
+				t.Fatalf("ReadInts(%q): %v", tt.in, err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ReadInts(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadIntsBadToken(t *testing.T) {
+	_, err := ReadInts(strings.NewReader("1 2\nthree 4"))
+	if err == nil {
+		t.Fatal("ReadInts succeeded, want error")
+	}
+	msg := err.Error()
+	for _, want := range []string{`"three"`, "token 3"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error %q does not mention %s", msg, want)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// sweepInterval is how often a Store removes expired entries in the
+// background. Get never returns an expired entry, so this only bounds how
+// long expired entries keep using memory.
+const sweepInterval = 100 * time.Millisecond
+
+type entry struct {
+	value     int
+	expiresAt time.Time
+}
+
+func (e entry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// Store is an in-memory key/value store whose entries can expire. It is
+// safe for concurrent use.
+type Store struct {
+	mu      sync.RWMutex
+	entries map[string]entry
+}
+
+// NewStore returns an empty Store and starts a goroutine that sweeps
+// expired entries until ctx is cancelled.
+func NewStore(ctx context.Context) *Store {
+	s := &Store{entries: make(map[string]entry)}
+	go s.sweepLoop(ctx)
+	return s
+}
+
+// Set stores value under key, replacing any previous value. The entry
+// expires after ttl; a ttl of zero or less means it never expires.
+func (s *Store) Set(key string, value int, ttl time.Duration) {
+	e := entry{value: value}
+	if ttl > 0 {
+		e.expiresAt = time.Now().Add(ttl)
+	}
+	s.mu.Lock()
+	s.entries[key] = e
+	s.mu.Unlock()
+}
+
+// Get returns the value stored under key. It returns false if the key is
+// missing or its entry has expired.
+func (s *Store) Get(key string) (int, bool) {
+	s.mu.RLock()
+	e, ok := s.entries[key]
+	s.mu.RUnlock()
+	if !ok || e.expired(time.Now()) {
+		return 0, false
+	}
+	return e.value, true
+}
+
+// Delete removes key from the store. Deleting a missing key is a no-op.
+func (s *Store) Delete(key string) {
+	s.mu.Lock()
+	delete(s.entries, key)
+	s.mu.Unlock()
+}
+
+func (s *Store) sweepLoop(ctx context.Context) {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.sweep(now)
+		}
+	}
+}
+
+func (s *Store) sweep(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, e := range s.entries {
+		if e.expired(now) {
+			delete(s.entries, key)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func (s *Store) len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.entries)
+}
+
+func TestStoreSetGetDelete(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewStore(ctx)
+
+	s.Set("code", 1, 0)
+	if v, ok := s.Get("code"); !ok || v != 1 {
+		t.Errorf("Get(code) = %d, %t, want 1, true", v, ok)
+	}
+	s.Set("code", 2, 0)
+	if v, _ := s.Get("code"); v != 2 {
+		t.Errorf("Get after overwrite = %d, want 2", v)
+	}
+	s.Delete("code")
+	s.Delete("missing")
+	if _, ok := s.Get("code"); ok {
+		t.Error("Get after Delete reported ok")
+	}
+}
+
+func TestStoreGetExpired(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewStore(ctx)
+
+	s.Set("short", 1, 20*time.Millisecond)
+	s.Set("forever", 2, 0)
+	if _, ok := s.Get("short"); !ok {
+		t.Fatal("Get(short) before TTL reported missing")
+	}
+	time.Sleep(40 * time.Millisecond)
+	if _, ok := s.Get("short"); ok {
+		t.Error("Get(short) after TTL reported ok")
+	}
+	if _, ok := s.Get("forever"); !ok {
+		t.Error("Get(forever) reported missing")
+	}
+}
+
+func TestStoreSweep(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewStore(ctx)
+
+	s.Set("short", 1, time.Minute)
+	s.Set("long", 2, time.Hour)
+	s.Set("forever", 3, 0)
+
+	s.sweep(time.Now())
+	if got := s.len(); got != 3 {
+		t.Fatalf("after early sweep store holds %d entries, want 3", got)
+	}
+	s.sweep(time.Now().Add(2 * time.Minute))
+	if got := s.len(); got != 2 {
+		t.Errorf("after sweeping past the short TTL store holds %d entries, want 2", got)
+	}
+	s.sweep(time.Now().Add(2 * time.Hour))
+	if got := s.len(); got != 1 {
+		t.Errorf("after sweeping past every TTL store holds %d entries, want 1", got)
+	}
+}
+
+func TestStoreBackgroundSweep(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewStore(ctx)
+
+	s.Set("short", 1, time.Millisecond)
+	deadline := time.Now().Add(5 * sweepInterval)
+	for s.len() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("background sweeper did not remove the expired entry")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestStoreConcurrentAccess(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewStore(ctx)
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				key := strconv.Itoa(i % 10)
+				s.Set(key, g, time.Millisecond)
+				s.Get(key)
+				if i%7 == 0 {
+					s.Delete(key)
+				}
+				if i%50 == 0 {
+					s.sweep(time.Now())
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Level is the severity of a log message.
+type Level int
+
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+)
+
+var levelNames = [...]string{
+	Debug: "debug",
+	Info:  "info",
+	Warn:  "warn",
+	Error: "error",
+}
+
+func (l Level) String() string {
+	if l < 0 || int(l) >= len(levelNames) {
+		return fmt.Sprintf("Level(%d)", int(l))
+	}
+	return levelNames[l]
+}
+
+// ParseLevel returns the Level named by s, ignoring case. For an unknown
+// name it returns Info and an error, so a caller that ignores the error
+// does not end up logging at Debug.
+func ParseLevel(s string) (Level, error) {
+	for l, name := range levelNames {
+		if strings.EqualFold(s, name) {
+			return Level(l), nil
+		}
+	}
+	return Info, fmt.Errorf("unknown level %q, want one of %s", s, strings.Join(levelNames[:], ", "))
+}
+
+// logf writes a formatted line to out, prefixed with level, when level is
+// at or above the threshold configured in cfg.
+func logf(out io.Writer, cfg Config, level Level, format string, args ...any) {
+	if level < cfg.Level {
+		return
+	}
+	fmt.Fprintf(out, "[%s] %s\n", level, fmt.Sprintf(format, args...))
+}
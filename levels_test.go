@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestLevelRoundTrip(t *testing.T) {
+	for _, l := range []Level{Debug, Info, Warn, Error} {
+		for _, s := range []string{l.String(), strings.ToUpper(l.String()), strings.ToUpper(l.String()[:1]) + l.String()[1:]} {
+			got, err := ParseLevel(s)
+			if err != nil {
+				t.Errorf("ParseLevel(%q): %v", s, err)
+				continue
+			}
+			if got != l {
+				t.Errorf("ParseLevel(%q) = %v, want %v", s, got, l)
+			}
+		}
+	}
+}
+
+func TestParseLevelUnknown(t *testing.T) {
+	for _, s := range []string{"", "verbose", "warning", "debug "} {
+		got, err := ParseLevel(s)
+		if err == nil {
+			t.Errorf("ParseLevel(%q) succeeded, want error", s)
+		}
+		if got != Info {
+			t.Errorf("ParseLevel(%q) = %v on error, want Info", s, got)
+		}
+	}
+}
+
+func TestLevelStringOutOfRange(t *testing.T) {
+	if got := Level(9).String(); got != "Level(9)" {
+		t.Errorf("Level(9).String() = %q, want \"Level(9)\"", got)
+	}
+}
+
+func TestLogfThreshold(t *testing.T) {
+	var buf bytes.Buffer
+	cfg := DefaultConfig()
+	cfg.Level = Warn
+	logf(&buf, cfg, Debug, "hidden %d", 1)
+	logf(&buf, cfg, Info, "hidden %d", 2)
+	logf(&buf, cfg, Warn, "shown %d", 3)
+	logf(&buf, cfg, Error, "shown %d", 4)
+	if want := "[warn] shown 3\n[error] shown 4\n"; buf.String() != want {
+		t.Errorf("logf output = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestLoadConfigLevel(t *testing.T) {
+	clearDemoEnv(t)
+	t.Setenv("DEMO_LEVEL", "WARN")
+	if cfg, err := LoadConfig(); err != nil || cfg.Level != Warn {
+		t.Errorf("LoadConfig with DEMO_LEVEL=WARN = %v, %v, want Warn", cfg.Level, err)
+	}
+
+	t.Setenv("DEMO_VERBOSE", "1")
+	if cfg, err := LoadConfig(); err != nil || cfg.Level != Debug {
+		t.Errorf("LoadConfig with DEMO_VERBOSE=1 = %v, %v, want Debug", cfg.Level, err)
+	}
+
+	t.Setenv("DEMO_LEVEL", "loud")
+	if _, err := LoadConfig(); err == nil {
+		t.Error("LoadConfig with DEMO_LEVEL=loud succeeded, want error")
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const lineCountBufSize = 32 * 1024
+
+// CountLines counts the lines in r, reading it in fixed-size chunks so
+// memory use does not grow with the input. A final line without a
+// trailing newline still counts as a line; empty input has zero lines.
+func CountLines(r io.Reader) (int, error) {
+	buf := make([]byte, lineCountBufSize)
+	lines := 0
+	var last byte = '\n'
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			lines += bytes.Count(buf[:n], []byte{'\n'})
+			last = buf[n-1]
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("count lines: %w", err)
+		}
+	}
+	if last != '\n' {
+		lines++
+	}
+	return lines, nil
+}
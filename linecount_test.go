@@ -0,0 +1,81 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestCountLines(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want int
+	}{
+		{"empty", "", 0},
+		{"single newline", "\n", 1},
+		{"trailing newline", "a\nb\n", 2},
+		{"no trailing newline", "a\nb", 2},
+		{"single line without newline", "hello", 1},
+		{"blank lines", "\n\n\n", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CountLines(strings.NewReader(tt.in))
+			if err != nil {
+				t.Fatalf("CountLines(%q): %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("CountLines(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+			// Reading one byte at a time must give the same answer.
+			got, err = CountLines(iotest.OneByteReader(strings.NewReader(tt.in)))
+			if err != nil || got != tt.want {
+				t.Errorf("CountLines one byte at a time = %d, %v, want %d", got, err, tt.want)
+			}
+		})
+	}
+}
+
+// lineReader produces size bytes of repeated synthLine without holding
+// them all in memory.
+type lineReader struct {
+	pos, size int
+}
+
+const synthLine = "0123456789\n"
+
+func (r *lineReader) Read(p []byte) (int, error) {
+	if r.pos == r.size {
+		return 0, io.EOF
+	}
+	n := 0
+	for n < len(p) && r.pos < r.size {
+		c := copy(p[n:], synthLine[r.pos%len(synthLine):])
+		c = min(c, r.size-r.pos)
+		n += c
+		r.pos += c
+	}
+	return n, nil
+}
+
+func TestCountLinesLargeInput(t *testing.T) {
+	const lines = 500_000 // about 5.5 MB
+	got, err := CountLines(&lineReader{size: lines * len(synthLine)})
+	if err != nil {
+		t.Fatalf("CountLines: %v", err)
+	}
+	if got != lines {
+		t.Errorf("CountLines = %d, want %d", got, lines)
+	}
+}
+
+func TestCountLinesReadError(t *testing.T) {
+	errRead := errors.New("disk on fire")
+	r := io.MultiReader(strings.NewReader("a\nb\n"), iotest.ErrReader(errRead))
+	if _, err := CountLines(r); !errors.Is(err, errRead) {
+		t.Errorf("CountLines error = %v, want %v", err, errRead)
+	}
+}
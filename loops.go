@@ -0,0 +1,61 @@
+package main
+
+// The functions below do the same work in different ways so their cost
+// can be compared with the benchmarks in bench_test.go.
+
+// SumIndexLoop sums nums using an index-based for loop.
+func SumIndexLoop(nums []int) int {
+	total := 0
+	for i := 0; i < len(nums); i++ {
+		total += nums[i]
+	}
+	return total
+}
+
+// SumRangeLoop sums nums using a for-range loop.
+func SumRangeLoop(nums []int) int {
+	total := 0
+	for _, n := range nums {
+		total += n
+	}
+	return total
+}
+
+// TotalLenIndexLoop adds up the lengths of names using an index-based
+// for loop.
+func TotalLenIndexLoop(names []string) int {
+	total := 0
+	for i := 0; i < len(names); i++ {
+		total += len(names[i])
+	}
+	return total
+}
+
+// TotalLenRangeLoop adds up the lengths of names using a for-range loop.
+func TotalLenRangeLoop(names []string) int {
+	total := 0
+	for _, name := range names {
+		total += len(name)
+	}
+	return total
+}
+
+// AppendGrow builds a slice of n ints starting from an empty slice, so
+// append has to reallocate as it grows.
+func AppendGrow(n int) []int {
+	var out []int
+	for i := 0; i < n; i++ {
+		out = append(out, i)
+	}
+	return out
+}
+
+// AppendPrealloc builds a slice of n ints with its capacity reserved up
+// front, so append never reallocates.
+func AppendPrealloc(n int) []int {
+	out := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		out = append(out, i)
+	}
+	return out
+}
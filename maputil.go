@@ -0,0 +1,80 @@
+package main
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Keys returns the keys of m. The order is unspecified and may differ
+// between calls; use SortedKeys for a stable order.
+func Keys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns the values of m. The order is unspecified and may differ
+// between calls.
+func Values[K comparable, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// SortedKeys returns the keys of m in ascending order.
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := Keys(m)
+	slices.Sort(keys)
+	return keys
+}
+
+// SortedRange calls f for each entry of m in ascending key order, giving
+// a stable alternative to ranging over the map directly.
+func SortedRange[K cmp.Ordered, V any](m map[K]V, f func(K, V)) {
+	for _, k := range SortedKeys(m) {
+		f(k, m[k])
+	}
+}
+
+// GroupBy groups the elements of s by the key returned from key. Within
+// each group the elements keep their order from s. An empty s yields an
+// empty, non-nil map.
+func GroupBy[T any, K comparable](s []T, key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, v := range s {
+		k := key(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// CloneMap returns a shallow copy of m; later changes to either map do not
+// affect the other. A nil m yields nil.
+func CloneMap[K comparable, V any](m map[K]V) map[K]V {
+	if m == nil {
+		return nil
+	}
+	out := make(map[K]V, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
+
+// MapEqual reports whether a and b hold the same keys mapped to equal
+// values. A nil map and an empty map are equal.
+func MapEqual[K, V comparable](a, b map[K]V) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, va := range a {
+		if vb, ok := b[k]; !ok || va != vb {
+			return false
+		}
+	}
+	return true
+}
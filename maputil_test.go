@@ -0,0 +1,151 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSortedKeys(t *testing.T) {
+	m := map[string]int{}
+	for _, k := range []string{"pear", "apple", "fig", "banana", "cherry"} {
+		m[k] = len(k)
+	}
+	want := []string{"apple", "banana", "cherry", "fig", "pear"}
+	if got := SortedKeys(m); !slices.Equal(got, want) {
+		t.Errorf("SortedKeys = %v, want %v", got, want)
+	}
+}
+
+func TestSortedRange(t *testing.T) {
+	m := map[int]string{}
+	for _, k := range []int{42, 7, 19, -3, 100, 0} {
+		m[k] = string(rune('a' + len(m)))
+	}
+
+	var keys []int
+	var values []string
+	SortedRange(m, func(k int, v string) {
+		keys = append(keys, k)
+		values = append(values, v)
+	})
+
+	wantKeys := []int{-3, 0, 7, 19, 42, 100}
+	if !slices.Equal(keys, wantKeys) {
+		t.Errorf("SortedRange keys = %v, want %v", keys, wantKeys)
+	}
+	for i, k := range keys {
+		if values[i] != m[k] {
+			t.Errorf("SortedRange value for %d = %q, want %q", k, values[i], m[k])
+		}
+	}
+}
+
+func TestSortedRangeEmpty(t *testing.T) {
+	calls := 0
+	SortedRange(map[string]int{}, func(string, int) { calls++ })
+	if calls != 0 {
+		t.Errorf("SortedRange on empty map called f %d times, want 0", calls)
+	}
+}
+
+func TestKeysAndValues(t *testing.T) {
+	m := map[string]int{"code": 1, "id": 23, "zip": 9}
+
+	keys := Keys(m)
+	if len(keys) != len(m) {
+		t.Fatalf("len(Keys) = %d, want %d", len(keys), len(m))
+	}
+	seen := map[string]bool{}
+	for _, k := range keys {
+		if _, ok := m[k]; !ok {
+			t.Errorf("Keys returned %q, which is not in the map", k)
+		}
+		if seen[k] {
+			t.Errorf("Keys returned %q twice", k)
+		}
+		seen[k] = true
+	}
+
+	values := Values(m)
+	if len(values) != len(m) {
+		t.Fatalf("len(Values) = %d, want %d", len(values), len(m))
+	}
+	slices.Sort(values)
+	if want := []int{1, 9, 23}; !slices.Equal(values, want) {
+		t.Errorf("sorted Values = %v, want %v", values, want)
+	}
+
+	if got := Keys(map[string]int(nil)); got == nil || len(got) != 0 {
+		t.Errorf("Keys(nil) = %#v, want empty non-nil slice", got)
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	names := []string{"Raghu", "Mahesh", "Ramesh", "Shilesh", "Ravi"}
+	got := GroupBy(names, func(s string) byte { return s[0] })
+
+	want := map[byte][]string{
+		'R': {"Raghu", "Ramesh", "Ravi"},
+		'M': {"Mahesh"},
+		'S': {"Shilesh"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("GroupBy produced %d groups, want %d: %v", len(got), len(want), got)
+	}
+	for k, w := range want {
+		if !slices.Equal(got[k], w) {
+			t.Errorf("group %c = %v, want %v", k, got[k], w)
+		}
+	}
+}
+
+func TestGroupByEmpty(t *testing.T) {
+	got := GroupBy([]int(nil), func(n int) int { return n })
+	if got == nil || len(got) != 0 {
+		t.Errorf("GroupBy(nil) = %#v, want empty non-nil map", got)
+	}
+}
+
+func TestCloneMap(t *testing.T) {
+	if got := CloneMap(map[string]int(nil)); got != nil {
+		t.Errorf("CloneMap(nil) = %#v, want nil", got)
+	}
+
+	orig := map[string]int{"code": 1, "id": 23}
+	clone := CloneMap(orig)
+	clone["code"] = 99
+	clone["new"] = 5
+	delete(orig, "id")
+	if len(orig) != 1 || orig["code"] != 1 {
+		t.Errorf("mutating the clone changed the original: %v", orig)
+	}
+	if len(clone) != 3 || clone["id"] != 23 {
+		t.Errorf("mutating the original changed the clone: %v", clone)
+	}
+}
+
+func TestMapEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b map[string]int
+		want bool
+	}{
+		{"equal", map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}, true},
+		{"different length", map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}, false},
+		{"same length different value", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 3}, false},
+		{"same length different key", map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "c": 2}, false},
+		{"zero value vs missing key", map[string]int{"a": 0}, map[string]int{"b": 0}, false},
+		{"nil vs empty", nil, map[string]int{}, true},
+		{"nil vs non-empty", nil, map[string]int{"a": 1}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("MapEqual(%v, %v) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+			if got := MapEqual(tt.b, tt.a); got != tt.want {
+				t.Errorf("MapEqual(%v, %v) = %t, want %t", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+var errNegative = errors.New("n must not be negative")
+
+// Fib returns the nth Fibonacci number, with Fib(0) == 0 and Fib(1) == 1.
+// It returns an error for negative n and for results that overflow
+// uint64; Fib(93) is the largest value that fits.
+func Fib(n int) (uint64, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("fib(%d): %w", n, errNegative)
+	}
+	var a, b uint64 = 0, 1
+	for i := 0; i < n; i++ {
+		next, carry := bits.Add64(a, b, 0)
+		if carry != 0 && i < n-1 {
+			return 0, fmt.Errorf("fib(%d): overflows uint64", n)
+		}
+		a, b = b, next
+	}
+	return a, nil
+}
+
+// Factorial returns n!. It returns an error for negative n and for
+// results that overflow uint64; 20! is the largest value that fits.
+func Factorial(n int) (uint64, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("factorial(%d): %w", n, errNegative)
+	}
+	result := uint64(1)
+	for i := 2; i <= n; i++ {
+		hi, lo := bits.Mul64(result, uint64(i))
+		if hi != 0 {
+			return 0, fmt.Errorf("factorial(%d): overflows uint64", n)
+		}
+		result = lo
+	}
+	return result, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFib(t *testing.T) {
+	tests := []struct {
+		n       int
+		want    uint64
+		wantErr bool
+	}{
+		{0, 0, false},
+		{1, 1, false},
+		{2, 1, false},
+		{10, 55, false},
+		{93, 12200160415121876738, false},
+		{94, 0, true},
+		{200, 0, true},
+		{-1, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := Fib(tt.n)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Fib(%d) error = %v, wantErr %v", tt.n, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Fib(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestFactorial(t *testing.T) {
+	tests := []struct {
+		n       int
+		want    uint64
+		wantErr bool
+	}{
+		{0, 1, false},
+		{1, 1, false},
+		{5, 120, false},
+		{20, 2432902008176640000, false},
+		{21, 0, true},
+		{-1, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := Factorial(tt.n)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Factorial(%d) error = %v, wantErr %v", tt.n, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Factorial(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestNegativeWrapsErrNegative(t *testing.T) {
+	if _, err := Fib(-5); !errors.Is(err, errNegative) {
+		t.Errorf("Fib(-5) error = %v, want errNegative", err)
+	}
+	if _, err := Factorial(-5); !errors.Is(err, errNegative) {
+		t.Errorf("Factorial(-5) error = %v, want errNegative", err)
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Matrix is a rectangular grid of ints stored row by row.
+type Matrix [][]int
+
+// NewMatrix returns a rows x cols matrix of zeros.
+func NewMatrix(rows, cols int) Matrix {
+	m := make(Matrix, rows)
+	for i := range m {
+		m[i] = make([]int, cols)
+	}
+	return m
+}
+
+// Rows returns the number of rows in m.
+func (m Matrix) Rows() int {
+	return len(m)
+}
+
+// Cols returns the number of columns in m.
+func (m Matrix) Cols() int {
+	if len(m) == 0 {
+		return 0
+	}
+	return len(m[0])
+}
+
+// isRectangular reports whether every row of m has the same length.
+func (m Matrix) isRectangular() bool {
+	for _, row := range m {
+		if len(row) != m.Cols() {
+			return false
+		}
+	}
+	return true
+}
+
+// Transpose returns a new matrix whose rows are the columns of m. If m is
+// ragged, the result is as wide as the longest row and missing cells are
+// zero.
+func (m Matrix) Transpose() Matrix {
+	cols := 0
+	for _, row := range m {
+		cols = max(cols, len(row))
+	}
+	t := NewMatrix(cols, m.Rows())
+	for i, row := range m {
+		for j, v := range row {
+			t[j][i] = v
+		}
+	}
+	return t
+}
+
+// Add returns the element-wise sum of m and other. It returns an error
+// when either matrix is ragged or the two do not have the same
+// dimensions.
+func (m Matrix) Add(other Matrix) (Matrix, error) {
+	if !m.isRectangular() || !other.isRectangular() {
+		return nil, errors.New("matrix add: rows have differing lengths")
+	}
+	if m.Rows() != other.Rows() || m.Cols() != other.Cols() {
+		return nil, fmt.Errorf("matrix add: dimension mismatch %dx%d and %dx%d",
+			m.Rows(), m.Cols(), other.Rows(), other.Cols())
+	}
+	sum := NewMatrix(m.Rows(), m.Cols())
+	for i, row := range m {
+		for j, v := range row {
+			sum[i][j] = v + other[i][j]
+		}
+	}
+	return sum, nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewMatrix(t *testing.T) {
+	m := NewMatrix(2, 3)
+	if m.Rows() != 2 || m.Cols() != 3 {
+		t.Errorf("NewMatrix(2, 3) is %dx%d", m.Rows(), m.Cols())
+	}
+	if want := (Matrix{{0, 0, 0}, {0, 0, 0}}); !reflect.DeepEqual(m, want) {
+		t.Errorf("NewMatrix(2, 3) = %v, want %v", m, want)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	tests := []struct {
+		name string
+		in   Matrix
+		want Matrix
+	}{
+		{"non-square", Matrix{{1, 2, 3}, {4, 5, 6}}, Matrix{{1, 4}, {2, 5}, {3, 6}}},
+		{"square", Matrix{{1, 2}, {3, 4}}, Matrix{{1, 3}, {2, 4}}},
+		{"single row", Matrix{{1, 2, 3}}, Matrix{{1}, {2}, {3}}},
+		{"ragged", Matrix{{1}, {2, 3}}, Matrix{{1, 2}, {0, 3}}},
+		{"empty", Matrix{}, Matrix{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.in.Transpose(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Transpose(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	a := Matrix{{1, 2, 3}, {4, 5, 6}}
+	got, err := a.Add(Matrix{{10, 20, 30}, {40, 50, 60}})
+	if err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if want := (Matrix{{11, 22, 33}, {44, 55, 66}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Add = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(a, Matrix{{1, 2, 3}, {4, 5, 6}}) {
+		t.Errorf("Add modified its receiver: %v", a)
+	}
+}
+
+func TestAddErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b Matrix
+	}{
+		{"row mismatch", Matrix{{1, 2}}, Matrix{{1, 2}, {3, 4}}},
+		{"column mismatch", Matrix{{1, 2}, {3, 4}}, Matrix{{1}, {2}}},
+		{"transposed shape", Matrix{{1, 2, 3}}, Matrix{{1}, {2}, {3}}},
+		{"ragged other", Matrix{{1, 2}, {3, 4}}, Matrix{{1, 2}, {3}}},
+		{"ragged receiver", Matrix{{1, 2}, {3, 4, 5}}, Matrix{{1, 2}, {3, 4}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := tt.a.Add(tt.b); err == nil {
+				t.Errorf("Add(%v, %v) = %v, want error", tt.a, tt.b, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import "sync"
+
+type memoEntry[V any] struct {
+	once  sync.Once
+	value V
+}
+
+// Memoize returns a function that calls fn at most once per distinct
+// argument and returns the cached result on later calls. It is safe for
+// concurrent use: callers racing on the same argument wait for a single
+// call to fn. fn may call the memoized function recursively with other
+// arguments, but not with the argument it is currently computing.
+func Memoize[K comparable, V any](fn func(K) V) func(K) V {
+	var mu sync.Mutex
+	cache := make(map[K]*memoEntry[V])
+	return func(k K) V {
+		mu.Lock()
+		e, ok := cache[k]
+		if !ok {
+			e = &memoEntry[V]{}
+			cache[k] = e
+		}
+		mu.Unlock()
+
+		e.once.Do(func() { e.value = fn(k) })
+		return e.value
+	}
+}
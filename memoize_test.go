@@ -0,0 +1,77 @@
+package main
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMemoizeCachesPerKey(t *testing.T) {
+	calls := map[int]int{}
+	square := Memoize(func(n int) int {
+		calls[n]++
+		return n * n
+	})
+	for i := 0; i < 3; i++ {
+		for _, n := range []int{2, 3, 2, 5} {
+			if got := square(n); got != n*n {
+				t.Fatalf("square(%d) = %d, want %d", n, got, n*n)
+			}
+		}
+	}
+	for _, n := range []int{2, 3, 5} {
+		if calls[n] != 1 {
+			t.Errorf("fn(%d) called %d times, want 1", n, calls[n])
+		}
+	}
+}
+
+func TestMemoizeRecursive(t *testing.T) {
+	var calls int
+	var fib func(int) uint64
+	fib = Memoize(func(n int) uint64 {
+		calls++
+		if n < 2 {
+			return uint64(n)
+		}
+		return fib(n-1) + fib(n-2)
+	})
+	if got := fib(90); got != 2880067194370816120 {
+		t.Errorf("fib(90) = %d, want 2880067194370816120", got)
+	}
+	if calls != 91 {
+		t.Errorf("fn called %d times, want 91 (once per n in 0..90)", calls)
+	}
+}
+
+func TestMemoizeConcurrent(t *testing.T) {
+	const keys, goroutines = 10, 50
+	var calls [keys]atomic.Int32
+	double := Memoize(func(n int) int {
+		calls[n].Add(1)
+		return n * 2
+	})
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			for n := 0; n < keys; n++ {
+				if got := double(n); got != n*2 {
+					t.Errorf("double(%d) = %d, want %d", n, got, n*2)
+				}
+			}
+		}()
+	}
+	close(start)
+	wg.Wait()
+
+	for n := range calls {
+		if c := calls[n].Load(); c != 1 {
+			t.Errorf("fn(%d) called %d times, want 1", n, c)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+// Optional holds either a value (Some) or nothing (None). It generalises
+// the comma-ok idiom so "maybe a value" can be passed around as one value.
+// The zero value is None.
+type Optional[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns an Optional holding v.
+func Some[T any](v T) Optional[T] {
+	return Optional[T]{value: v, ok: true}
+}
+
+// None returns an empty Optional.
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// Lookup returns the value stored under k in m, or None if k is missing.
+func Lookup[K comparable, V any](m map[K]V, k K) Optional[V] {
+	if v, ok := m[k]; ok {
+		return Some(v)
+	}
+	return None[V]()
+}
+
+// Get returns the held value and true, or the zero value and false for
+// None.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// OrElse returns the held value, or def for None.
+func (o Optional[T]) OrElse(def T) T {
+	if !o.ok {
+		return def
+	}
+	return o.value
+}
+
+// Map returns Some(f(v)) if o holds v, and None otherwise; f is not
+// called for None. Go methods cannot add type parameters, so f must
+// return the same type T.
+func (o Optional[T]) Map(f func(T) T) Optional[T] {
+	if !o.ok {
+		return o
+	}
+	return Some(f(o.value))
+}
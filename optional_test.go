@@ -0,0 +1,54 @@
+package main
+
+import "testing"
+
+func TestOptionalSome(t *testing.T) {
+	o := Some(21)
+	if v, ok := o.Get(); !ok || v != 21 {
+		t.Errorf("Some(21).Get() = %d, %t, want 21, true", v, ok)
+	}
+	if got := o.OrElse(-1); got != 21 {
+		t.Errorf("Some(21).OrElse(-1) = %d, want 21", got)
+	}
+	doubled := o.Map(func(v int) int { return v * 2 })
+	if v, ok := doubled.Get(); !ok || v != 42 {
+		t.Errorf("Some(21).Map(double).Get() = %d, %t, want 42, true", v, ok)
+	}
+}
+
+func TestOptionalNone(t *testing.T) {
+	for name, o := range map[string]Optional[int]{"None": None[int](), "zero value": {}} {
+		t.Run(name, func(t *testing.T) {
+			if v, ok := o.Get(); ok || v != 0 {
+				t.Errorf("Get() = %d, %t, want 0, false", v, ok)
+			}
+			if got := o.OrElse(-1); got != -1 {
+				t.Errorf("OrElse(-1) = %d, want -1", got)
+			}
+			called := false
+			mapped := o.Map(func(v int) int {
+				called = true
+				return v * 2
+			})
+			if called {
+				t.Error("Map called f on None")
+			}
+			if _, ok := mapped.Get(); ok {
+				t.Error("Map on None produced a value")
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	m := map[string]int{"id": 23, "zero": 0}
+	if v, ok := Lookup(m, "id").Get(); !ok || v != 23 {
+		t.Errorf("Lookup(id) = %d, %t, want 23, true", v, ok)
+	}
+	if v, ok := Lookup(m, "zero").Get(); !ok || v != 0 {
+		t.Errorf("Lookup(zero) = %d, %t, want 0, true", v, ok)
+	}
+	if _, ok := Lookup(m, "missing").Get(); ok {
+		t.Error("Lookup(missing) reported a value")
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseIntFlexible parses s as a signed 64-bit integer after trimming
+// surrounding whitespace. The base comes from the prefix: 0x for hex, 0o
+// for octal, 0b for binary and none for decimal. Because it relies on
+// strconv.ParseInt with base 0, a bare leading 0 also means octal and
+// underscores are allowed between digits.
+func ParseIntFlexible(s string) (int64, error) {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 0, 64)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, fmt.Errorf("parse int %q: out of range for int64", s)
+		}
+		return 0, fmt.Errorf("parse int %q: not a valid decimal, 0x, 0o or 0b integer", s)
+	}
+	return n, nil
+}
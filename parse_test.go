@@ -0,0 +1,52 @@
+package main
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParseIntFlexible(t *testing.T) {
+	tests := []struct {
+		in   string
+		want int64
+	}{
+		{"42", 42},
+		{"0x2A", 42},
+		{"0X2a", 42},
+		{"0o52", 42},
+		{"052", 42},
+		{"0b101010", 42},
+		{"-42", -42},
+		{"-0x2A", -42},
+		{"-0b101010", -42},
+		{"+7", 7},
+		{"  \t42\n", 42},
+		{"1_000", 1000},
+		{"9223372036854775807", math.MaxInt64},
+		{"-9223372036854775808", math.MinInt64},
+	}
+	for _, tt := range tests {
+		got, err := ParseIntFlexible(tt.in)
+		if err != nil {
+			t.Errorf("ParseIntFlexible(%q): %v", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseIntFlexible(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseIntFlexibleErrors(t *testing.T) {
+	for _, in := range []string{"12abc", "", "  ", "0x", "0b102", "0o8", "1.5", "9223372036854775808"} {
+		_, err := ParseIntFlexible(in)
+		if err == nil {
+			t.Errorf("ParseIntFlexible(%q) succeeded, want error", in)
+			continue
+		}
+		if !strings.Contains(err.Error(), `"`+in+`"`) {
+			t.Errorf("ParseIntFlexible(%q) error %q does not name the input", in, err)
+		}
+	}
+}
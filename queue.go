@@ -0,0 +1,66 @@
+package main
+
+const minQueueCap = 8
+
+// Queue is a first-in, first-out collection backed by a ring buffer. The
+// buffer doubles when full and halves once it is a quarter full, so a
+// queue that drains does not keep holding its peak capacity. The zero
+// value is an empty queue ready to use.
+type Queue[T any] struct {
+	buf  []T
+	head int
+	n    int
+}
+
+// Enqueue adds v to the back of the queue.
+func (q *Queue[T]) Enqueue(v T) {
+	if q.n == len(q.buf) {
+		q.resize(max(2*len(q.buf), minQueueCap))
+	}
+	q.buf[(q.head+q.n)%len(q.buf)] = v
+	q.n++
+}
+
+// Dequeue removes and returns the front of the queue. On an empty queue
+// it returns the zero value and false.
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zero T
+	if q.n == 0 {
+		return zero, false
+	}
+	v := q.buf[q.head]
+	// Clear the slot so the dequeued value can be garbage collected.
+	q.buf[q.head] = zero
+	q.head = (q.head + 1) % len(q.buf)
+	q.n--
+	if len(q.buf) > minQueueCap && q.n <= len(q.buf)/4 {
+		q.resize(len(q.buf) / 2)
+	}
+	return v, true
+}
+
+// Peek returns the front of the queue without removing it. On an empty
+// queue it returns the zero value and false.
+func (q *Queue[T]) Peek() (T, bool) {
+	if q.n == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.buf[q.head], true
+}
+
+// Len returns the number of items in the queue.
+func (q *Queue[T]) Len() int {
+	return q.n
+}
+
+// resize copies the queued items, in order, into a new buffer of the
+// given capacity starting at index 0.
+func (q *Queue[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	for i := 0; i < q.n; i++ {
+		buf[i] = q.buf[(q.head+i)%len(q.buf)]
+	}
+	q.buf = buf
+	q.head = 0
+}
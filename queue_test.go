@@ -0,0 +1,67 @@
+package main
+
+import "testing"
+
+func TestQueueFIFO(t *testing.T) {
+	const n = 10000
+	var q Queue[int]
+	for i := 0; i < n; i++ {
+		q.Enqueue(i)
+		if q.Len() != i+1 {
+			t.Fatalf("Len after %d enqueues = %d", i+1, q.Len())
+		}
+	}
+	peak := len(q.buf)
+	if peak < n {
+		t.Fatalf("buffer capacity %d is smaller than %d queued items", peak, n)
+	}
+
+	for i := 0; i < n; i++ {
+		if front, ok := q.Peek(); !ok || front != i {
+			t.Fatalf("Peek = %d, %t, want %d, true", front, ok, i)
+		}
+		got, ok := q.Dequeue()
+		if !ok || got != i {
+			t.Fatalf("Dequeue = %d, %t, want %d, true", got, ok, i)
+		}
+		if q.Len() != n-i-1 {
+			t.Fatalf("Len after %d dequeues = %d, want %d", i+1, q.Len(), n-i-1)
+		}
+	}
+	if len(q.buf) != minQueueCap {
+		t.Errorf("buffer capacity after draining = %d, want it to shrink to %d (peak %d)", len(q.buf), minQueueCap, peak)
+	}
+}
+
+func TestQueueInterleaved(t *testing.T) {
+	var q Queue[int]
+	next, want := 0, 0
+	for round := 0; round < 1000; round++ {
+		// Enqueue three and dequeue two each round so head wraps around
+		// the ring while the queue slowly grows.
+		for i := 0; i < 3; i++ {
+			q.Enqueue(next)
+			next++
+		}
+		for i := 0; i < 2; i++ {
+			got, ok := q.Dequeue()
+			if !ok || got != want {
+				t.Fatalf("Dequeue = %d, %t, want %d, true", got, ok, want)
+			}
+			want++
+		}
+	}
+	if q.Len() != next-want {
+		t.Errorf("Len = %d, want %d", q.Len(), next-want)
+	}
+}
+
+func TestQueueEmpty(t *testing.T) {
+	var q Queue[string]
+	if v, ok := q.Dequeue(); ok || v != "" {
+		t.Errorf("Dequeue on empty queue = %q, %t, want \"\", false", v, ok)
+	}
+	if v, ok := q.Peek(); ok || v != "" {
+		t.Errorf("Peek on empty queue = %q, %t, want \"\", false", v, ok)
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+// Limiter is a token-bucket rate limiter. The bucket holds up to
+// perSecond tokens, starts full and is refilled by one token every
+// 1/perSecond seconds, so it allows short bursts while keeping the
+// long-run rate at perSecond. It is safe for concurrent use.
+type Limiter struct {
+	tokens chan struct{}
+	ticker *time.Ticker
+	done   chan struct{}
+}
+
+// maxRate is the highest rate NewLimiter accepts: one token per
+// nanosecond, the resolution of time.Ticker.
+const maxRate = int(time.Second)
+
+// NewLimiter returns a Limiter allowing perSecond calls to Wait per
+// second and starts its refill goroutine; call Stop to release it.
+// NewLimiter panics if perSecond is not positive or exceeds maxRate,
+// since the refill interval cannot be shorter than a nanosecond.
+func NewLimiter(perSecond int) *Limiter {
+	if perSecond <= 0 {
+		panic("NewLimiter: perSecond must be positive")
+	}
+	if perSecond > maxRate {
+		panic("NewLimiter: perSecond must not exceed one token per nanosecond")
+	}
+	l := &Limiter{
+		tokens: make(chan struct{}, perSecond),
+		ticker: time.NewTicker(time.Second / time.Duration(perSecond)),
+		done:   make(chan struct{}),
+	}
+	for i := 0; i < perSecond; i++ {
+		l.tokens <- struct{}{}
+	}
+	go l.refill()
+	return l
+}
+
+func (l *Limiter) refill() {
+	for {
+		select {
+		case <-l.done:
+			return
+		case <-l.ticker.C:
+			select {
+			case l.tokens <- struct{}{}:
+			default:
+				// The bucket is full; drop the token.
+			}
+		}
+	}
+}
+
+// Wait blocks until a token is available and takes it. If ctx is
+// cancelled first, Wait returns ctx.Err() without taking a token.
+func (l *Limiter) Wait(ctx context.Context) error {
+	select {
+	case <-l.tokens:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stop stops refilling the bucket. Waits already in progress or started
+// later block until their context is cancelled once the remaining tokens
+// are used up. Stop must be called at most once.
+func (l *Limiter) Stop() {
+	l.ticker.Stop()
+	close(l.done)
+}
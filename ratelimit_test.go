@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLimiterRate(t *testing.T) {
+	const perSecond = 50
+	l := NewLimiter(perSecond)
+	defer l.Stop()
+	ctx := context.Background()
+
+	// The bucket starts full, so the first perSecond calls do not wait.
+	start := time.Now()
+	for i := 0; i < perSecond; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait: %v", err)
+		}
+	}
+	if burst := time.Since(start); burst > 50*time.Millisecond {
+		t.Errorf("initial burst of %d took %v, want it to be immediate", perSecond, burst)
+	}
+
+	// Ten more calls need ten refills at 20ms each.
+	start = time.Now()
+	for i := 0; i < 10; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatalf("Wait: %v", err)
+		}
+	}
+	elapsed := time.Since(start)
+	if elapsed < 150*time.Millisecond || elapsed > time.Second {
+		t.Errorf("10 calls after the burst took %v, want about 200ms", elapsed)
+	}
+}
+
+func TestLimiterWaitCancel(t *testing.T) {
+	l := NewLimiter(1)
+	defer l.Stop()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("Wait: %v", err)
+	}
+
+	// The bucket is now empty and the next token is a second away.
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+	start := time.Now()
+	err := l.Wait(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Wait error = %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("Wait took %v to notice cancellation, want prompt return", elapsed)
+	}
+}
+
+func TestNewLimiterPanicsOnInvalidRate(t *testing.T) {
+	for _, perSecond := range []int{0, -1, maxRate + 1, math.MaxInt} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewLimiter(%d) did not panic", perSecond)
+				}
+			}()
+			NewLimiter(perSecond)
+		}()
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Retry calls fn until it succeeds or has been called attempts times,
+// returning the last error. Before each retry it waits baseDelay, doubled
+// after every failure. If ctx is cancelled while waiting, Retry returns
+// ctx.Err() without calling fn again.
+func Retry(ctx context.Context, attempts int, baseDelay time.Duration, fn func() error) error {
+	if attempts < 1 {
+		return fmt.Errorf("retry: attempts must be positive, got %d", attempts)
+	}
+
+	delay := baseDelay
+	var err error
+	for i := 0; i < attempts; i++ {
+		if i > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			}
+			delay *= 2
+		}
+		if err = fn(); err == nil {
+			return nil
+		}
+	}
+	return err
+}
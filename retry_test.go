@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetryStopsOnSuccess(t *testing.T) {
+	calls := 0
+	err := Retry(context.Background(), 5, time.Millisecond, func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("flaky")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Retry: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times, want 3", calls)
+	}
+}
+
+func TestRetryReturnsLastError(t *testing.T) {
+	calls := 0
+	errLast := errors.New("last")
+	err := Retry(context.Background(), 3, time.Millisecond, func() error {
+		calls++
+		if calls == 3 {
+			return errLast
+		}
+		return errors.New("earlier")
+	})
+	if !errors.Is(err, errLast) {
+		t.Errorf("Retry error = %v, want %v", err, errLast)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times, want 3", calls)
+	}
+}
+
+func TestRetryCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	start := time.Now()
+	err := Retry(ctx, 5, time.Hour, func() error {
+		calls++
+		cancel()
+		return errors.New("fail")
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Retry error = %v, want context.Canceled", err)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Retry took %v, want it to return promptly on cancel", elapsed)
+	}
+}
+
+func TestRetryInvalidAttempts(t *testing.T) {
+	called := false
+	err := Retry(context.Background(), 0, time.Millisecond, func() error {
+		called = true
+		return nil
+	})
+	if err == nil || called {
+		t.Errorf("Retry with 0 attempts = %v, called %t, want error and no call", err, called)
+	}
+}
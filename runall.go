@@ -0,0 +1,16 @@
+package main
+
+import "errors"
+
+// RunAll runs every task in order, even after one fails, and returns
+// their errors combined with errors.Join. It returns nil if every task
+// succeeds.
+func RunAll(tasks ...func() error) error {
+	var errs []error
+	for _, task := range tasks {
+		if err := task(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
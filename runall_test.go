@@ -0,0 +1,45 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRunAllSuccess(t *testing.T) {
+	calls := 0
+	ok := func() error { calls++; return nil }
+	if err := RunAll(ok, ok, ok); err != nil {
+		t.Errorf("RunAll = %v, want nil", err)
+	}
+	if calls != 3 {
+		t.Errorf("ran %d tasks, want 3", calls)
+	}
+	if err := RunAll(); err != nil {
+		t.Errorf("RunAll() = %v, want nil", err)
+	}
+}
+
+func TestRunAllCollectsEveryError(t *testing.T) {
+	errA := errors.New("a failed")
+	errB := errors.New("b failed")
+	calls := 0
+	err := RunAll(
+		func() error { calls++; return errA },
+		func() error { calls++; return nil },
+		func() error { calls++; return errB },
+	)
+	if calls != 3 {
+		t.Errorf("ran %d tasks, want 3: a failure stopped the rest", calls)
+	}
+	if err == nil {
+		t.Fatal("RunAll = nil, want joined error")
+	}
+	for _, want := range []error{errA, errB} {
+		if !errors.Is(err, want) {
+			t.Errorf("RunAll error %q does not contain %q", err, want)
+		}
+	}
+	if want := "a failed\nb failed"; err.Error() != want {
+		t.Errorf("RunAll error = %q, want %q", err, want)
+	}
+}
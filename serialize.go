@@ -0,0 +1,29 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DemoData groups the values used by the demos so they can be encoded
+// together. encoding/json writes map keys in sorted order, so the encoded
+// form of a given value is stable.
+type DemoData struct {
+	Names   []string       `json:"names"`
+	Scores  map[string]int `json:"scores"`
+	Numbers []int          `json:"numbers"`
+}
+
+// ToJSON encodes d as JSON.
+func (d DemoData) ToJSON() ([]byte, error) {
+	return json.Marshal(d)
+}
+
+// FromJSON decodes a DemoData from b.
+func FromJSON(b []byte) (DemoData, error) {
+	var d DemoData
+	if err := json.Unmarshal(b, &d); err != nil {
+		return DemoData{}, fmt.Errorf("decode demo data: %w", err)
+	}
+	return d, nil
+}
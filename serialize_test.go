@@ -0,0 +1,51 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDemoDataToJSON(t *testing.T) {
+	d := DemoData{
+		Names:   []string{"Raghu", "Mahesh"},
+		Scores:  map[string]int{"id": 23, "code": 1, "alpha": 0},
+		Numbers: []int{1, 2, 3},
+	}
+	want := `{"names":["Raghu","Mahesh"],"scores":{"alpha":0,"code":1,"id":23},"numbers":[1,2,3]}`
+	for i := 0; i < 10; i++ {
+		got, err := d.ToJSON()
+		if err != nil {
+			t.Fatalf("ToJSON: %v", err)
+		}
+		if string(got) != want {
+			t.Fatalf("ToJSON = %s, want %s", got, want)
+		}
+	}
+}
+
+func TestFromJSONRoundTrip(t *testing.T) {
+	d := DemoData{
+		Names:   myNames,
+		Scores:  map[string]int{"code": 1, "id": 23},
+		Numbers: []int{1, 2, 3},
+	}
+	b, err := d.ToJSON()
+	if err != nil {
+		t.Fatalf("ToJSON: %v", err)
+	}
+	got, err := FromJSON(b)
+	if err != nil {
+		t.Fatalf("FromJSON: %v", err)
+	}
+	if !reflect.DeepEqual(got, d) {
+		t.Errorf("round trip = %+v, want %+v", got, d)
+	}
+}
+
+func TestFromJSONMalformed(t *testing.T) {
+	for _, in := range []string{``, `{`, `{"names":"Raghu"}`, `{"numbers":[1,"two"]}`, `[]`} {
+		if _, err := FromJSON([]byte(in)); err == nil {
+			t.Errorf("FromJSON(%q) succeeded, want error", in)
+		}
+	}
+}
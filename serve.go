@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+)
+
+// serve runs until ctx is cancelled. Every interval it records a tick in
+// a Store whose entries expire after a few intervals, so the background
+// sweeper has work to do, and reports the tick to out. It always returns
+// ctx.Err().
+func serve(ctx context.Context, out io.Writer, interval time.Duration) error {
+	store := NewStore(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for n := 1; ; n++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			key := fmt.Sprintf("tick-%d", n)
+			store.Set(key, n, 3*interval)
+			_, previous := store.Get(fmt.Sprintf("tick-%d", n-1))
+			fmt.Fprintf(out, "%s stored, previous tick live: %t\n", key, previous)
+		}
+	}
+}
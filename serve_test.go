@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServeStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var buf bytes.Buffer
+
+	done := make(chan error, 1)
+	go func() {
+		done <- serve(ctx, &buf, 5*time.Millisecond)
+	}()
+	time.Sleep(30 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("serve returned %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("serve did not return after cancel")
+	}
+
+	// serve has returned, so reading buf no longer races with it.
+	out := buf.String()
+	if !strings.Contains(out, "tick-1 stored, previous tick live: false\n") {
+		t.Errorf("serve output missing first tick:\n%s", out)
+	}
+	if !strings.Contains(out, "tick-2 stored, previous tick live: true\n") {
+		t.Errorf("serve output missing second tick:\n%s", out)
+	}
+}
+
+func TestServeCancelledBeforeStart(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var buf bytes.Buffer
+	if err := serve(ctx, &buf, time.Hour); !errors.Is(err, context.Canceled) {
+		t.Errorf("serve returned %v, want context.Canceled", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("serve wrote %q before any tick", buf.String())
+	}
+}
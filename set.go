@@ -0,0 +1,90 @@
+package main
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Set is an unordered collection of distinct values. The zero value is an
+// empty set ready to use.
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewSet returns a set holding the given items.
+func NewSet[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(items))}
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+	return s
+}
+
+// Add inserts item into the set.
+func (s *Set[T]) Add(item T) {
+	if s.items == nil {
+		s.items = make(map[T]struct{})
+	}
+	s.items[item] = struct{}{}
+}
+
+// Remove deletes item from the set. Removing a missing item is a no-op.
+func (s *Set[T]) Remove(item T) {
+	delete(s.items, item)
+}
+
+// Contains reports whether item is in the set.
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Len returns the number of items in the set.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// Union returns a new set holding the items of both s and other.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	out := NewSet[T]()
+	for item := range s.items {
+		out.items[item] = struct{}{}
+	}
+	for item := range other.items {
+		out.items[item] = struct{}{}
+	}
+	return out
+}
+
+// Intersect returns a new set holding the items present in both s and
+// other.
+func (s *Set[T]) Intersect(other *Set[T]) *Set[T] {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+	out := NewSet[T]()
+	for item := range small.items {
+		if large.Contains(item) {
+			out.items[item] = struct{}{}
+		}
+	}
+	return out
+}
+
+// Slice returns the items of the set in unspecified order. Use
+// SortedSlice when T is ordered and a stable order is needed.
+func (s *Set[T]) Slice() []T {
+	out := make([]T, 0, len(s.items))
+	for item := range s.items {
+		out = append(out, item)
+	}
+	return out
+}
+
+// SortedSlice returns the items of s in ascending order.
+func SortedSlice[T cmp.Ordered](s *Set[T]) []T {
+	out := s.Slice()
+	slices.Sort(out)
+	return out
+}
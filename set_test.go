@@ -0,0 +1,52 @@
+package main
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSetBasics(t *testing.T) {
+	var s Set[string]
+	s.Add("a")
+	s.Add("b")
+	s.Add("a")
+	if s.Len() != 2 {
+		t.Errorf("Len = %d, want 2", s.Len())
+	}
+	if !s.Contains("a") || s.Contains("c") {
+		t.Errorf("Contains gave wrong answers for %v", s.Slice())
+	}
+	s.Remove("a")
+	s.Remove("missing")
+	if s.Contains("a") || s.Len() != 1 {
+		t.Errorf("after Remove, set = %v, want [b]", s.Slice())
+	}
+}
+
+func TestSetUnionIntersect(t *testing.T) {
+	tests := []struct {
+		name          string
+		a, b          []int
+		wantUnion     []int
+		wantIntersect []int
+	}{
+		{"overlapping", []int{1, 2, 3}, []int{2, 3, 4}, []int{1, 2, 3, 4}, []int{2, 3}},
+		{"disjoint", []int{1, 2}, []int{3, 4}, []int{1, 2, 3, 4}, []int{}},
+		{"subset", []int{1, 2, 3}, []int{2}, []int{1, 2, 3}, []int{2}},
+		{"empty", nil, []int{1}, []int{1}, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NewSet(tt.a...), NewSet(tt.b...)
+			if got := SortedSlice(a.Union(b)); !slices.Equal(got, tt.wantUnion) {
+				t.Errorf("Union = %v, want %v", got, tt.wantUnion)
+			}
+			if got := SortedSlice(a.Intersect(b)); !slices.Equal(got, tt.wantIntersect) {
+				t.Errorf("Intersect = %v, want %v", got, tt.wantIntersect)
+			}
+			if got := SortedSlice(a); !slices.Equal(got, SortedSlice(NewSet(tt.a...))) {
+				t.Errorf("Union/Intersect modified the receiver: %v", got)
+			}
+		})
+	}
+}
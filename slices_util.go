@@ -0,0 +1,298 @@
+package main
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+// Map returns a new slice holding f applied to each element of in.
+// A nil or empty input yields a non-nil empty slice.
+func Map[T, U any](in []T, f func(T) U) []U {
+	out := make([]U, 0, len(in))
+	for _, v := range in {
+		out = append(out, f(v))
+	}
+	return out
+}
+
+// Filter returns the elements of in for which pred reports true.
+// A nil or empty input yields a non-nil empty slice.
+func Filter[T any](in []T, pred func(T) bool) []T {
+	out := make([]T, 0)
+	for _, v := range in {
+		if pred(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// Partition splits s in a single pass into the elements for which pred
+// reports true and the rest, both in their original order. Either result
+// may be empty but is never nil.
+func Partition[T any](s []T, pred func(T) bool) (matched, rest []T) {
+	matched, rest = make([]T, 0), make([]T, 0)
+	for _, v := range s {
+		if pred(v) {
+			matched = append(matched, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matched, rest
+}
+
+// Reduce folds in from left to right, starting with init.
+// A nil or empty input returns init unchanged.
+func Reduce[T, U any](in []T, init U, f func(U, T) U) U {
+	acc := init
+	for _, v := range in {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// IndexOf returns the index of the first element of s equal to target,
+// or -1 if target is not present.
+func IndexOf[T comparable](s []T, target T) int {
+	for i, v := range s {
+		if v == target {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains reports whether target is present in s.
+func Contains[T comparable](s []T, target T) bool {
+	return IndexOf(s, target) >= 0
+}
+
+// Reverse reverses the elements of s in place.
+func Reverse[T any](s []T) {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Reversed returns a new slice holding the elements of s in reverse
+// order. s is left unchanged.
+func Reversed[T any](s []T) []T {
+	out := make([]T, len(s))
+	for i, v := range s {
+		out[len(s)-1-i] = v
+	}
+	return out
+}
+
+// Chunk splits s into consecutive sub-slices of at most size elements;
+// the last chunk holds any remainder. The chunks share s's backing array
+// but are capped so appending to one never overwrites the next. An empty
+// s yields an empty result. Chunk panics if size is not positive, as
+// that is a programming error rather than a runtime condition.
+func Chunk[T any](s []T, size int) [][]T {
+	if size <= 0 {
+		panic("Chunk: size must be positive")
+	}
+	n := len(s) / size
+	if len(s)%size != 0 {
+		n++
+	}
+	chunks := make([][]T, 0, n)
+	for start := 0; start < len(s); start += size {
+		end := min(start+size, len(s))
+		chunks = append(chunks, s[start:end:end])
+	}
+	return chunks
+}
+
+// Dedup returns a new slice holding the distinct elements of s in the
+// order they first appear. s is left unchanged.
+func Dedup[T comparable](s []T) []T {
+	seen := make(map[T]struct{}, len(s))
+	out := make([]T, 0, len(s))
+	for _, v := range s {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
+
+// Pair holds two values of possibly different types.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs up the elements of as and bs by index. The result is as long
+// as the shorter of the two; extra elements of the longer one are
+// dropped.
+func Zip[A, B any](as []A, bs []B) []Pair[A, B] {
+	n := min(len(as), len(bs))
+	pairs := make([]Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		pairs[i] = Pair[A, B]{First: as[i], Second: bs[i]}
+	}
+	return pairs
+}
+
+// Unzip splits pairs into a slice of first values and a slice of second
+// values, the inverse of Zip.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.First, p.Second
+	}
+	return as, bs
+}
+
+// BinarySearch searches the ascending slice s for target. If target is
+// present it returns the index of its first occurrence and true;
+// otherwise it returns the index where target would be inserted to keep
+// s sorted, and false, matching sort.Search.
+func BinarySearch[T cmp.Ordered](s []T, target T) (index int, found bool) {
+	lo, hi := 0, len(s)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if s[mid] < target {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	return lo, lo < len(s) && s[lo] == target
+}
+
+// CloneSlice returns a shallow copy of s that does not share its backing
+// array. A nil s yields nil.
+func CloneSlice[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(s)), s...)
+}
+
+// Flatten concatenates the inner slices of nested in order into a single
+// slice allocated once at its final size. nil inner slices contribute
+// nothing, and an empty nested yields a non-nil empty slice.
+func Flatten[T any](nested [][]T) []T {
+	n := 0
+	for _, inner := range nested {
+		n += len(inner)
+	}
+	out := make([]T, 0, n)
+	for _, inner := range nested {
+		out = append(out, inner...)
+	}
+	return out
+}
+
+// SortBy sorts s in place by ascending key(v). The sort is stable, so
+// elements with equal keys keep their relative order. Sort descending by
+// negating a numeric key.
+func SortBy[T any, K cmp.Ordered](s []T, key func(T) K) {
+	slices.SortStableFunc(s, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
+
+// SortedBy returns a copy of s sorted as by SortBy, leaving s unchanged.
+func SortedBy[T any, K cmp.Ordered](s []T, key func(T) K) []T {
+	out := CloneSlice(s)
+	SortBy(out, key)
+	return out
+}
+
+// MaxBy returns the largest element of s according to less, and true. On
+// ties the first such element wins. It returns the zero value and false
+// for an empty s.
+func MaxBy[T any](s []T, less func(a, b T) bool) (T, bool) {
+	var best T
+	if len(s) == 0 {
+		return best, false
+	}
+	best = s[0]
+	for _, v := range s[1:] {
+		if less(best, v) {
+			best = v
+		}
+	}
+	return best, true
+}
+
+// MinBy returns the smallest element of s according to less, and true. On
+// ties the first such element wins. It returns the zero value and false
+// for an empty s.
+func MinBy[T any](s []T, less func(a, b T) bool) (T, bool) {
+	var best T
+	if len(s) == 0 {
+		return best, false
+	}
+	best = s[0]
+	for _, v := range s[1:] {
+		if less(v, best) {
+			best = v
+		}
+	}
+	return best, true
+}
+
+// Range returns the integers from start up to but not including end,
+// stepping by step. A negative step counts down from start towards end.
+// The result is empty, not nil, when start == end or step points away
+// from end. Range panics if step is zero, as such a range never ends.
+func Range(start, end, step int) []int {
+	if step == 0 {
+		panic("Range: step must not be zero")
+	}
+	out := make([]int, 0)
+	// Stop before i += step would wrap around near the ends of int.
+	if step > 0 {
+		for i := start; i < end; i += step {
+			out = append(out, i)
+			if i > math.MaxInt-step {
+				break
+			}
+		}
+	} else {
+		for i := start; i > end; i += step {
+			out = append(out, i)
+			if i < math.MinInt-step {
+				break
+			}
+		}
+	}
+	return out
+}
+
+// Repeat returns a slice holding count copies of value. Repeat panics if
+// count is negative.
+func Repeat[T any](value T, count int) []T {
+	if count < 0 {
+		panic("Repeat: count must not be negative")
+	}
+	out := make([]T, count)
+	for i := range out {
+		out[i] = value
+	}
+	return out
+}
+
+// SliceEqual reports whether a and b have the same length and equal
+// elements at every index. A nil slice and an empty slice are equal.
+func SliceEqual[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,504 @@
+package main
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []string
+		want []int
+	}{
+		{"names to lengths", myNames, []int{5, 6, 7}},
+		{"empty", []string{}, []int{}},
+		{"nil", nil, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Map(tt.in, func(s string) int { return len(s) })
+			if got == nil {
+				t.Fatal("Map returned nil, want non-nil slice")
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Map = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"evens", []int{1, 2, 3, 4, 5, 6}, []int{2, 4, 6}},
+		{"no match", []int{1, 3, 5}, []int{}},
+		{"empty", []int{}, []int{}},
+		{"nil", nil, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Filter(tt.in, isEven)
+			if got == nil {
+				t.Fatal("Filter returned nil, want non-nil slice")
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Filter = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReduce(t *testing.T) {
+	sum := func(acc, n int) int { return acc + n }
+	tests := []struct {
+		name string
+		in   []int
+		init int
+		want int
+	}{
+		{"sum", []int{20, 23}, 0, 43},
+		{"with init", []int{1, 2, 3}, 10, 16},
+		{"empty returns init", []int{}, 7, 7},
+		{"nil returns init", nil, 7, 7},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Reduce(tt.in, tt.init, sum); got != tt.want {
+				t.Errorf("Reduce = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexOfAndContains(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      []string
+		target string
+		want   int
+	}{
+		{"first match", myNames, "Raghu", 0},
+		{"later match", myNames, "Mahesh", 1},
+		{"not found", myNames, "Srikanth", -1},
+		{"duplicates return first", []string{"a", "b", "a", "b"}, "b", 1},
+		{"empty", nil, "a", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IndexOf(tt.s, tt.target); got != tt.want {
+				t.Errorf("IndexOf(%v, %q) = %d, want %d", tt.s, tt.target, got, tt.want)
+			}
+			if got, want := Contains(tt.s, tt.target), tt.want >= 0; got != want {
+				t.Errorf("Contains(%v, %q) = %t, want %t", tt.s, tt.target, got, want)
+			}
+		})
+	}
+}
+
+func TestReverse(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"empty", []int{}, []int{}},
+		{"single", []int{1}, []int{1}},
+		{"even length", []int{1, 2, 3, 4}, []int{4, 3, 2, 1}},
+		{"odd length", []int{1, 2, 3}, []int{3, 2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := slices.Clone(tt.in)
+			got := Reversed(tt.in)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Reversed(%v) = %v, want %v", orig, got, tt.want)
+			}
+			if !slices.Equal(tt.in, orig) {
+				t.Errorf("Reversed modified its input: %v, want %v", tt.in, orig)
+			}
+
+			Reverse(tt.in)
+			if !slices.Equal(tt.in, tt.want) {
+				t.Errorf("Reverse(%v) = %v, want %v", orig, tt.in, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		size int
+		want [][]int
+	}{
+		{"exact multiple", []int{1, 2, 3, 4, 5, 6}, 3, [][]int{{1, 2, 3}, {4, 5, 6}}},
+		{"remainder", []int{1, 2, 3, 4, 5, 6, 7}, 3, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}},
+		{"size larger than slice", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"max int size", []int{1, 2}, math.MaxInt, [][]int{{1, 2}}},
+		{"empty", []int{}, 2, [][]int{}},
+		{"nil", nil, 2, [][]int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Chunk(tt.in, tt.size)
+			if got == nil {
+				t.Fatal("Chunk returned nil, want non-nil result")
+			}
+			if !slices.EqualFunc(got, tt.want, slices.Equal[[]int]) {
+				t.Errorf("Chunk(%v, %d) = %v, want %v", tt.in, tt.size, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkAppendDoesNotClobber(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	chunks := Chunk(s, 2)
+	_ = append(chunks[0], 99)
+	if !slices.Equal(chunks[1], []int{3, 4}) {
+		t.Errorf("appending to the first chunk changed the second: %v", chunks[1])
+	}
+}
+
+func TestChunkPanicsOnNonPositiveSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Chunk with size %d did not panic", size)
+				}
+			}()
+			Chunk([]int{1, 2}, size)
+		}()
+	}
+}
+
+func TestDedup(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []int
+		want []int
+	}{
+		{"keeps first occurrence order", []int{3, 1, 3, 2, 1, 3}, []int{3, 1, 2}},
+		{"all duplicates", []int{7, 7, 7, 7}, []int{7}},
+		{"no duplicates", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"empty", []int{}, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := slices.Clone(tt.in)
+			got := Dedup(tt.in)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Dedup(%v) = %v, want %v", orig, got, tt.want)
+			}
+			if !slices.Equal(tt.in, orig) {
+				t.Errorf("Dedup modified its input: %v, want %v", tt.in, orig)
+			}
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name string
+		as   []string
+		bs   []int
+		want []Pair[string, int]
+	}{
+		{"equal length", []string{"a", "b"}, []int{1, 2}, []Pair[string, int]{{"a", 1}, {"b", 2}}},
+		{"first shorter", []string{"a"}, []int{1, 2, 3}, []Pair[string, int]{{"a", 1}}},
+		{"second shorter", []string{"a", "b", "c"}, []int{1, 2}, []Pair[string, int]{{"a", 1}, {"b", 2}}},
+		{"one empty", []string{"a"}, nil, []Pair[string, int]{}},
+		{"both empty", nil, nil, []Pair[string, int]{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Zip(tt.as, tt.bs)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Zip(%v, %v) = %v, want %v", tt.as, tt.bs, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnzip(t *testing.T) {
+	as, bs := Unzip([]Pair[string, int]{{"a", 1}, {"b", 2}})
+	if !slices.Equal(as, []string{"a", "b"}) || !slices.Equal(bs, []int{1, 2}) {
+		t.Errorf("Unzip = %v, %v, want [a b], [1 2]", as, bs)
+	}
+
+	as, bs = Unzip[string, int](nil)
+	if len(as) != 0 || len(bs) != 0 {
+		t.Errorf("Unzip(nil) = %v, %v, want empty slices", as, bs)
+	}
+
+	names, scores := Unzip(Zip(myNames, []int{90, 75, 82, 60}))
+	if !slices.Equal(names, myNames) || !slices.Equal(scores, []int{90, 75, 82}) {
+		t.Errorf("Unzip(Zip) = %v, %v", names, scores)
+	}
+}
+
+func TestBinarySearch(t *testing.T) {
+	sorted := []int{1, 3, 3, 3, 5, 8}
+	tests := []struct {
+		name      string
+		s         []int
+		target    int
+		wantIndex int
+		wantFound bool
+	}{
+		{"empty", nil, 4, 0, false},
+		{"smaller than all", sorted, 0, 0, false},
+		{"larger than all", sorted, 9, 6, false},
+		{"exact first", sorted, 1, 0, true},
+		{"exact last", sorted, 8, 5, true},
+		{"duplicates return first", sorted, 3, 1, true},
+		{"missing in middle", sorted, 4, 4, false},
+		{"single match", []int{5}, 5, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, found := BinarySearch(tt.s, tt.target)
+			if index != tt.wantIndex || found != tt.wantFound {
+				t.Errorf("BinarySearch(%v, %d) = %d, %t, want %d, %t", tt.s, tt.target, index, found, tt.wantIndex, tt.wantFound)
+			}
+			if sortIndex, sortFound := slices.BinarySearch(tt.s, tt.target); sortIndex != index || sortFound != found {
+				t.Errorf("BinarySearch disagrees with slices.BinarySearch: %d, %t", sortIndex, sortFound)
+			}
+		})
+	}
+}
+
+func TestCloneSlice(t *testing.T) {
+	if got := CloneSlice([]int(nil)); got != nil {
+		t.Errorf("CloneSlice(nil) = %#v, want nil", got)
+	}
+	if got := CloneSlice([]int{}); got == nil || len(got) != 0 {
+		t.Errorf("CloneSlice(empty) = %#v, want empty non-nil slice", got)
+	}
+
+	orig := []int{20, 23}
+	clone := CloneSlice(orig)
+	clone[0] = 99
+	orig[1] = 42
+	if !slices.Equal(orig, []int{20, 42}) || !slices.Equal(clone, []int{99, 23}) {
+		t.Errorf("after mutation orig = %v, clone = %v; want [20 42], [99 23]", orig, clone)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	isEven := func(n int) bool { return n%2 == 0 }
+	tests := []struct {
+		name        string
+		in          []int
+		wantMatched []int
+		wantRest    []int
+	}{
+		{"mixed keeps order", []int{5, 2, 7, 4, 1, 6}, []int{2, 4, 6}, []int{5, 7, 1}},
+		{"all true", []int{2, 4}, []int{2, 4}, []int{}},
+		{"all false", []int{1, 3}, []int{}, []int{1, 3}},
+		{"empty", nil, []int{}, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, rest := Partition(tt.in, isEven)
+			if matched == nil || rest == nil {
+				t.Fatalf("Partition returned nil half: %#v, %#v", matched, rest)
+			}
+			if !slices.Equal(matched, tt.wantMatched) || !slices.Equal(rest, tt.wantRest) {
+				t.Errorf("Partition(%v) = %v, %v, want %v, %v", tt.in, matched, rest, tt.wantMatched, tt.wantRest)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name string
+		in   [][]int
+		want []int
+	}{
+		{"jagged", [][]int{{1, 2, 3}, {4}, {5, 6}}, []int{1, 2, 3, 4, 5, 6}},
+		{"nil and empty inner", [][]int{nil, {1}, {}, {2, 3}, nil}, []int{1, 2, 3}},
+		{"all inner nil", [][]int{nil, nil}, []int{}},
+		{"empty outer", [][]int{}, []int{}},
+		{"nil outer", nil, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Flatten(tt.in)
+			if got == nil {
+				t.Fatal("Flatten returned nil, want non-nil slice")
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Flatten(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+			if cap(got) != len(tt.want) {
+				t.Errorf("Flatten capacity = %d, want exactly %d", cap(got), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	type score struct {
+		name  string
+		score int
+	}
+	in := []score{{"Raghu", 90}, {"Mahesh", 75}, {"Shilesh", 90}, {"Ravi", 60}, {"Sai", 75}}
+	byScore := func(s score) int { return s.score }
+
+	t.Run("ascending and stable", func(t *testing.T) {
+		got := slices.Clone(in)
+		SortBy(got, byScore)
+		want := []score{{"Ravi", 60}, {"Mahesh", 75}, {"Sai", 75}, {"Raghu", 90}, {"Shilesh", 90}}
+		if !slices.Equal(got, want) {
+			t.Errorf("SortBy = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("descending via negation and stable", func(t *testing.T) {
+		got := SortedBy(in, func(s score) int { return -s.score })
+		want := []score{{"Raghu", 90}, {"Shilesh", 90}, {"Mahesh", 75}, {"Sai", 75}, {"Ravi", 60}}
+		if !slices.Equal(got, want) {
+			t.Errorf("SortedBy = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("SortedBy leaves input unchanged", func(t *testing.T) {
+		orig := slices.Clone(in)
+		SortedBy(in, byScore)
+		if !slices.Equal(in, orig) {
+			t.Errorf("SortedBy modified its input: %v", in)
+		}
+	})
+
+	t.Run("string key", func(t *testing.T) {
+		got := SortedBy(in, func(s score) string { return s.name })
+		want := []string{"Mahesh", "Raghu", "Ravi", "Sai", "Shilesh"}
+		if names := Map(got, func(s score) string { return s.name }); !slices.Equal(names, want) {
+			t.Errorf("SortedBy name = %v, want %v", names, want)
+		}
+	})
+}
+
+func TestMaxByMinBy(t *testing.T) {
+	byLength := func(a, b string) bool { return len(a) < len(b) }
+	tests := []struct {
+		name    string
+		in      []string
+		wantMax string
+		wantMin string
+	}{
+		{"single", []string{"Go"}, "Go", "Go"},
+		{"distinct lengths", myNames, "Shilesh", "Raghu"},
+		{"ties keep first", []string{"bb", "aa", "c", "d", "ee"}, "bb", "c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, ok := MaxBy(tt.in, byLength); !ok || got != tt.wantMax {
+				t.Errorf("MaxBy(%v) = %q, %t, want %q, true", tt.in, got, ok, tt.wantMax)
+			}
+			if got, ok := MinBy(tt.in, byLength); !ok || got != tt.wantMin {
+				t.Errorf("MinBy(%v) = %q, %t, want %q, true", tt.in, got, ok, tt.wantMin)
+			}
+		})
+	}
+
+	if got, ok := MaxBy(nil, byLength); ok || got != "" {
+		t.Errorf("MaxBy(nil) = %q, %t, want \"\", false", got, ok)
+	}
+	if got, ok := MinBy([]string{}, byLength); ok || got != "" {
+		t.Errorf("MinBy(empty) = %q, %t, want \"\", false", got, ok)
+	}
+}
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		start, end, step int
+		want             []int
+	}{
+		{0, 10, 1, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+		{0, 10, 3, []int{0, 3, 6, 9}},
+		{10, 0, -3, []int{10, 7, 4, 1}},
+		{3, -2, -1, []int{3, 2, 1, 0, -1}},
+		{5, 5, 1, []int{}},
+		{5, 5, -1, []int{}},
+		{0, 3, -1, []int{}},
+		{3, 0, 1, []int{}},
+		{math.MaxInt - 1, math.MaxInt, 2, []int{math.MaxInt - 1}},
+		{math.MaxInt - 3, math.MaxInt, 2, []int{math.MaxInt - 3, math.MaxInt - 1}},
+		{0, math.MaxInt, math.MaxInt, []int{0}},
+		{math.MinInt + 1, math.MinInt, -2, []int{math.MinInt + 1}},
+		{math.MinInt + 3, math.MinInt, -2, []int{math.MinInt + 3, math.MinInt + 1}},
+		{0, math.MinInt, math.MinInt, []int{0}},
+	}
+	for _, tt := range tests {
+		got := Range(tt.start, tt.end, tt.step)
+		if got == nil {
+			t.Errorf("Range(%d, %d, %d) = nil, want non-nil slice", tt.start, tt.end, tt.step)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Range(%d, %d, %d) = %v, want %v", tt.start, tt.end, tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestRangePanicsOnZeroStep(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Range with step 0 did not panic")
+		}
+	}()
+	Range(0, 10, 0)
+}
+
+func TestRepeat(t *testing.T) {
+	if got := Repeat("go", 3); !slices.Equal(got, []string{"go", "go", "go"}) {
+		t.Errorf("Repeat(go, 3) = %v", got)
+	}
+	if got := Repeat(7, 0); got == nil || len(got) != 0 {
+		t.Errorf("Repeat(7, 0) = %#v, want empty non-nil slice", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Repeat with negative count did not panic")
+		}
+	}()
+	Repeat(1, -1)
+}
+
+func TestSliceEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want bool
+	}{
+		{"equal", []int{1, 2, 3}, []int{1, 2, 3}, true},
+		{"different length", []int{1, 2}, []int{1, 2, 3}, false},
+		{"same length different element", []int{1, 2, 3}, []int{1, 9, 3}, false},
+		{"same elements different order", []int{1, 2}, []int{2, 1}, false},
+		{"nil vs empty", nil, []int{}, true},
+		{"nil vs nil", nil, nil, true},
+		{"nil vs non-empty", nil, []int{1}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SliceEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("SliceEqual(%#v, %#v) = %t, want %t", tt.a, tt.b, got, tt.want)
+			}
+			if got := SliceEqual(tt.b, tt.a); got != tt.want {
+				t.Errorf("SliceEqual(%#v, %#v) = %t, want %t", tt.b, tt.a, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+// Stack is a last-in, first-out collection backed by a slice. The zero
+// value is an empty stack ready to use.
+type Stack[T any] struct {
+	items []T
+}
+
+// Push adds v to the top of the stack.
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+// Pop removes and returns the top of the stack. On an empty stack it
+// returns the zero value and false.
+func (s *Stack[T]) Pop() (T, bool) {
+	var zero T
+	if len(s.items) == 0 {
+		return zero, false
+	}
+	last := len(s.items) - 1
+	v := s.items[last]
+	// Clear the slot so the popped value can be garbage collected.
+	s.items[last] = zero
+	s.items = s.items[:last]
+	return v, true
+}
+
+// Peek returns the top of the stack without removing it. On an empty
+// stack it returns the zero value and false.
+func (s *Stack[T]) Peek() (T, bool) {
+	if len(s.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of items on the stack.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
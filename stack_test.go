@@ -0,0 +1,44 @@
+package main
+
+import "testing"
+
+func TestStackLIFO(t *testing.T) {
+	var s Stack[int]
+	for _, v := range []int{20, 23, 42} {
+		s.Push(v)
+	}
+	if top, ok := s.Peek(); !ok || top != 42 {
+		t.Errorf("Peek = %d, %t, want 42, true", top, ok)
+	}
+	if s.Len() != 3 {
+		t.Errorf("Len after Peek = %d, want 3", s.Len())
+	}
+	for _, want := range []int{42, 23, 20} {
+		got, ok := s.Pop()
+		if !ok || got != want {
+			t.Fatalf("Pop = %d, %t, want %d, true", got, ok, want)
+		}
+	}
+	if s.Len() != 0 {
+		t.Errorf("Len after draining = %d, want 0", s.Len())
+	}
+}
+
+func TestStackUnderflow(t *testing.T) {
+	var s Stack[string]
+	if v, ok := s.Pop(); ok || v != "" {
+		t.Errorf("Pop on empty stack = %q, %t, want \"\", false", v, ok)
+	}
+	if v, ok := s.Peek(); ok || v != "" {
+		t.Errorf("Peek on empty stack = %q, %t, want \"\", false", v, ok)
+	}
+
+	s.Push("a")
+	s.Pop()
+	if _, ok := s.Pop(); ok {
+		t.Error("Pop after draining reported ok")
+	}
+	if s.Len() != 0 {
+		t.Errorf("Len = %d, want 0", s.Len())
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import "fmt"
+
+// State is the state of a Turnstile.
+type State int
+
+const (
+	Locked State = iota
+	Unlocked
+)
+
+func (s State) String() string {
+	switch s {
+	case Locked:
+		return "Locked"
+	case Unlocked:
+		return "Unlocked"
+	default:
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+}
+
+// Turnstile is the classic coin-operated turnstile state machine. A coin
+// unlocks it and a push while unlocked lets one person through and locks
+// it again; any other event leaves the state unchanged. The zero value
+// is a locked turnstile.
+type Turnstile struct {
+	state State
+}
+
+// State returns the current state of t.
+func (t *Turnstile) State() State {
+	return t.state
+}
+
+// Coin inserts a coin and returns the new state.
+func (t *Turnstile) Coin() State {
+	switch t.state {
+	case Locked:
+		t.state = Unlocked
+	case Unlocked:
+		// Extra coins are accepted but do not change anything.
+	}
+	return t.state
+}
+
+// Push pushes the arm and returns the new state.
+func (t *Turnstile) Push() State {
+	switch t.state {
+	case Locked:
+		// The arm does not move while locked.
+	case Unlocked:
+		t.state = Locked
+	}
+	return t.state
+}
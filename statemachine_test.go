@@ -0,0 +1,54 @@
+package main
+
+import "testing"
+
+func TestTurnstile(t *testing.T) {
+	var ts Turnstile
+	if ts.State() != Locked {
+		t.Fatalf("zero Turnstile state = %v, want Locked", ts.State())
+	}
+
+	steps := []struct {
+		event string
+		want  State
+	}{
+		{"push", Locked}, // pushing while locked is a no-op
+		{"coin", Unlocked},
+		{"coin", Unlocked}, // extra coin while unlocked is a no-op
+		{"push", Locked},
+		{"push", Locked},
+		{"coin", Unlocked},
+		{"push", Locked},
+	}
+	for i, step := range steps {
+		var got State
+		switch step.event {
+		case "push":
+			got = ts.Push()
+		case "coin":
+			got = ts.Coin()
+		}
+		if got != step.want {
+			t.Fatalf("step %d (%s) = %v, want %v", i, step.event, got, step.want)
+		}
+		if ts.State() != got {
+			t.Fatalf("step %d: State() = %v, want %v", i, ts.State(), got)
+		}
+	}
+}
+
+func TestStateString(t *testing.T) {
+	tests := []struct {
+		s    State
+		want string
+	}{
+		{Locked, "Locked"},
+		{Unlocked, "Unlocked"},
+		{State(7), "State(7)"},
+	}
+	for _, tt := range tests {
+		if got := tt.s.String(); got != tt.want {
+			t.Errorf("State(%d).String() = %q, want %q", int(tt.s), got, tt.want)
+		}
+	}
+}
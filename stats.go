@@ -0,0 +1,41 @@
+package main
+
+import "errors"
+
+var errEmptySlice = errors.New("slice must not be empty")
+
+// Sum returns the sum of nums. The sum of an empty slice is 0.
+func Sum(nums []int) int {
+	total := 0
+	for _, n := range nums {
+		total += n
+	}
+	return total
+}
+
+// Average returns the arithmetic mean of nums. It returns an error when
+// nums is empty.
+func Average(nums []int) (float64, error) {
+	if len(nums) == 0 {
+		return 0, errEmptySlice
+	}
+	return float64(Sum(nums)) / float64(len(nums)), nil
+}
+
+// MinMax returns the smallest and largest values in nums. It returns an
+// error when nums is empty.
+func MinMax(nums []int) (min, max int, err error) {
+	if len(nums) == 0 {
+		return 0, 0, errEmptySlice
+	}
+	min, max = nums[0], nums[0]
+	for _, n := range nums[1:] {
+		if n < min {
+			min = n
+		}
+		if n > max {
+			max = n
+		}
+	}
+	return min, max, nil
+}
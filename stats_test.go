@@ -0,0 +1,48 @@
+package main
+
+import "testing"
+
+func TestSum(t *testing.T) {
+	if got := Sum(nil); got != 0 {
+		t.Errorf("Sum(nil) = %d, want 0", got)
+	}
+	if got := Sum([]int{1, 2, 3, -4}); got != 2 {
+		t.Errorf("Sum = %d, want 2", got)
+	}
+}
+
+func TestAverage(t *testing.T) {
+	got, err := Average([]int{1, 2, 3, 4})
+	if err != nil || got != 2.5 {
+		t.Errorf("Average = %v, %v, want 2.5, nil", got, err)
+	}
+	if _, err := Average(nil); err == nil {
+		t.Error("Average(nil) succeeded, want error")
+	}
+	if _, err := Average([]int{}); err == nil {
+		t.Error("Average(empty) succeeded, want error")
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       []int
+		min, max int
+	}{
+		{"single element", []int{7}, 7, 7},
+		{"unsorted", []int{3, -1, 9, 4}, -1, 9},
+		{"all equal", []int{2, 2, 2}, 2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lo, hi, err := MinMax(tt.in)
+			if err != nil || lo != tt.min || hi != tt.max {
+				t.Errorf("MinMax(%v) = %d, %d, %v, want %d, %d, nil", tt.in, lo, hi, err, tt.min, tt.max)
+			}
+		})
+	}
+	if _, _, err := MinMax(nil); err == nil {
+		t.Error("MinMax(nil) succeeded, want error")
+	}
+}
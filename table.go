@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// PrintTable writes headers and rows to w as left-aligned columns, with a
+// dashed rule under the headers. Each column is as wide as its widest
+// cell and columns are separated by two spaces. Rows shorter than the
+// widest row are padded with empty cells; trailing spaces are trimmed.
+func PrintTable(w io.Writer, headers []string, rows [][]string) {
+	ncols := len(headers)
+	for _, row := range rows {
+		ncols = max(ncols, len(row))
+	}
+	widths := make([]int, ncols)
+	measure := func(cells []string) {
+		for i, cell := range cells {
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+	measure(headers)
+	for _, row := range rows {
+		measure(row)
+	}
+
+	writeRow := func(cells []string) {
+		var b strings.Builder
+		for i, width := range widths {
+			cell := ""
+			if i < len(cells) {
+				cell = cells[i]
+			}
+			if i > 0 {
+				b.WriteString("  ")
+			}
+			b.WriteString(cell)
+			b.WriteString(strings.Repeat(" ", width-utf8.RuneCountInString(cell)))
+		}
+		fmt.Fprintln(w, strings.TrimRight(b.String(), " "))
+	}
+
+	writeRow(headers)
+	rule := make([]string, ncols)
+	for i, width := range widths {
+		rule[i] = strings.Repeat("-", width)
+	}
+	writeRow(rule)
+	for _, row := range rows {
+		writeRow(row)
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPrintTable(t *testing.T) {
+	var buf bytes.Buffer
+	PrintTable(&buf, []string{"key", "value", "note"}, [][]string{
+		{"code", "1", "first"},
+		{"identifier", "23"},
+		{"x", "", "ragged"},
+	})
+	want := "" +
+		"key         value  note\n" +
+		"----------  -----  ------\n" +
+		"code        1      first\n" +
+		"identifier  23\n" +
+		"x                  ragged\n"
+	if got := buf.String(); got != want {
+		t.Errorf("PrintTable output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPrintTableExtraCells(t *testing.T) {
+	var buf bytes.Buffer
+	PrintTable(&buf, []string{"a"}, [][]string{{"1", "extra"}})
+	want := "" +
+		"a\n" +
+		"-  -----\n" +
+		"1  extra\n"
+	if got := buf.String(); got != want {
+		t.Errorf("PrintTable output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPrintTableUnicodeWidth(t *testing.T) {
+	var buf bytes.Buffer
+	PrintTable(&buf, []string{"name", "n"}, [][]string{{"Zoë", "1"}, {"Al", "2"}})
+	want := "" +
+		"name  n\n" +
+		"----  -\n" +
+		"Zoë   1\n" +
+		"Al    2\n"
+	if got := buf.String(); got != want {
+		t.Errorf("PrintTable output:\n%s\nwant:\n%s", got, want)
+	}
+}
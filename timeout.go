@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+// RunWithTimeout runs fn in its own goroutine with a context that is
+// cancelled after d or when parent is done. If fn returns first, its
+// result is returned. Otherwise RunWithTimeout returns the zero value and
+// the context's error, context.DeadlineExceeded when d elapses, without
+// waiting for fn. fn should watch its context and return promptly once it
+// is cancelled; its late result is discarded and the goroutine still
+// exits because the result channel is buffered.
+func RunWithTimeout[T any](parent context.Context, d time.Duration, fn func(context.Context) (T, error)) (T, error) {
+	ctx, cancel := context.WithTimeout(parent, d)
+	defer cancel()
+
+	type result struct {
+		value T
+		err   error
+	}
+	done := make(chan result, 1)
+	go func() {
+		v, err := fn(ctx)
+		done <- result{v, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.value, r.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRunWithTimeoutFast(t *testing.T) {
+	got, err := RunWithTimeout(context.Background(), time.Second, func(ctx context.Context) (string, error) {
+		return "done", nil
+	})
+	if err != nil || got != "done" {
+		t.Errorf("RunWithTimeout = %q, %v, want \"done\", nil", got, err)
+	}
+}
+
+func TestRunWithTimeoutReturnsFnError(t *testing.T) {
+	errFn := errors.New("fn failed")
+	got, err := RunWithTimeout(context.Background(), time.Second, func(ctx context.Context) (int, error) {
+		return 7, errFn
+	})
+	if !errors.Is(err, errFn) || got != 7 {
+		t.Errorf("RunWithTimeout = %d, %v, want 7, %v", got, err, errFn)
+	}
+}
+
+func TestRunWithTimeoutDeadline(t *testing.T) {
+	exited := make(chan error, 1)
+	start := time.Now()
+	got, err := RunWithTimeout(context.Background(), 20*time.Millisecond, func(ctx context.Context) (int, error) {
+		defer func() { exited <- ctx.Err() }()
+		select {
+		case <-time.After(time.Minute):
+			return 42, nil
+		case <-ctx.Done():
+			return 42, ctx.Err()
+		}
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("RunWithTimeout error = %v, want context.DeadlineExceeded", err)
+	}
+	if got != 0 {
+		t.Errorf("RunWithTimeout value = %d on timeout, want zero value", got)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("RunWithTimeout took %v, want about 20ms", elapsed)
+	}
+
+	// fn must see cancellation and its goroutine must finish rather than
+	// leak.
+	select {
+	case ctxErr := <-exited:
+		if !errors.Is(ctxErr, context.DeadlineExceeded) {
+			t.Errorf("fn saw ctx.Err() = %v, want context.DeadlineExceeded", ctxErr)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("fn goroutine did not exit after the timeout")
+	}
+}
+
+func TestRunWithTimeoutLateResultDoesNotBlock(t *testing.T) {
+	// fn ignores its context and returns after RunWithTimeout has given up.
+	// The buffered result channel must let it exit anyway.
+	exited := make(chan struct{})
+	_, err := RunWithTimeout(context.Background(), 5*time.Millisecond, func(ctx context.Context) (int, error) {
+		defer close(exited)
+		time.Sleep(30 * time.Millisecond)
+		return 1, nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("RunWithTimeout error = %v, want context.DeadlineExceeded", err)
+	}
+	select {
+	case <-exited:
+	case <-time.After(time.Second):
+		t.Fatal("fn goroutine blocked after returning a late result")
+	}
+}
+
+func TestRunWithTimeoutParentCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := RunWithTimeout(ctx, time.Minute, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("RunWithTimeout error = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// WordCount pairs a word with the number of times it occurred.
+type WordCount struct {
+	Word  string
+	Count int
+}
+
+// WordFrequency counts the words in text. Words are maximal runs of
+// letters, so punctuation, digits and whitespace all act as separators,
+// and they are lowercased before counting.
+func WordFrequency(text string) map[string]int {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	freq := make(map[string]int)
+	for _, w := range words {
+		freq[w]++
+	}
+	return freq
+}
+
+// TopN returns the n most frequent words in freq, highest count first.
+// Words with equal counts are ordered alphabetically so the result is
+// deterministic. If n exceeds the number of words, all of them are
+// returned.
+func TopN(freq map[string]int, n int) []WordCount {
+	counts := make([]WordCount, 0, len(freq))
+	for w, c := range freq {
+		counts = append(counts, WordCount{Word: w, Count: c})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return counts[i].Word < counts[j].Word
+	})
+	if n < 0 {
+		n = 0
+	}
+	return counts[:min(n, len(counts))]
+}
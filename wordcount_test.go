@@ -0,0 +1,50 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWordFrequency(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want map[string]int
+	}{
+		{"punctuation", "Hello, world! Hello... world?", map[string]int{"hello": 2, "world": 2}},
+		{"case folding", "Go GO go gO", map[string]int{"go": 4}},
+		{"digits separate words", "abc123def", map[string]int{"abc": 1, "def": 1}},
+		{"unicode letters", "Grüße, grüße", map[string]int{"grüße": 2}},
+		{"empty", "", map[string]int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WordFrequency(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WordFrequency(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTopN(t *testing.T) {
+	freq := map[string]int{"pear": 2, "apple": 3, "fig": 2, "banana": 2, "kiwi": 1}
+	tests := []struct {
+		n    int
+		want []WordCount
+	}{
+		{2, []WordCount{{"apple", 3}, {"banana", 2}}},
+		{4, []WordCount{{"apple", 3}, {"banana", 2}, {"fig", 2}, {"pear", 2}}},
+		{10, []WordCount{{"apple", 3}, {"banana", 2}, {"fig", 2}, {"pear", 2}, {"kiwi", 1}}},
+		{0, []WordCount{}},
+		{-1, []WordCount{}},
+	}
+	for _, tt := range tests {
+		// Run several times: map iteration order changes between runs, the
+		// result must not.
+		for i := 0; i < 5; i++ {
+			if got := TopN(freq, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("TopN(%d) = %v, want %v", tt.n, got, tt.want)
+			}
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// SquareAll squares every element of nums using the given number of
+// worker goroutines. The result keeps the order of nums. If ctx is
+// cancelled before all work is done, SquareAll stops handing out work and
+// returns ctx.Err().
+func SquareAll(ctx context.Context, nums []int, workers int) ([]int, error) {
+	if workers < 1 {
+		return nil, fmt.Errorf("square all: workers must be positive, got %d", workers)
+	}
+
+	out := make([]int, len(nums))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				// Each index is handed to exactly one worker, so writes
+				// to out never overlap.
+				out[i] = nums[i] * nums[i]
+			}
+		}()
+	}
+
+send:
+	for i := range nums {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break send
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSquareAllOrder(t *testing.T) {
+	nums := make([]int, 1000)
+	for i := range nums {
+		nums[i] = i - 500
+	}
+	got, err := SquareAll(context.Background(), nums, 8)
+	if err != nil {
+		t.Fatalf("SquareAll: %v", err)
+	}
+	if len(got) != len(nums) {
+		t.Fatalf("len = %d, want %d", len(got), len(nums))
+	}
+	for i, n := range nums {
+		if got[i] != n*n {
+			t.Fatalf("got[%d] = %d, want %d", i, got[i], n*n)
+		}
+	}
+}
+
+func TestSquareAllEmpty(t *testing.T) {
+	got, err := SquareAll(context.Background(), nil, 4)
+	if err != nil || len(got) != 0 {
+		t.Errorf("SquareAll(nil) = %v, %v, want empty, nil", got, err)
+	}
+}
+
+func TestSquareAllInvalidWorkers(t *testing.T) {
+	if _, err := SquareAll(context.Background(), []int{1}, 0); err == nil {
+		t.Error("SquareAll with 0 workers succeeded, want error")
+	}
+}
+
+func TestSquareAllCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := SquareAll(ctx, []int{1, 2, 3}, 2); !errors.Is(err, context.Canceled) {
+		t.Errorf("SquareAll with cancelled ctx error = %v, want context.Canceled", err)
+	}
+}
+
+func TestSquareAllCancelMidFlight(t *testing.T) {
+	// Large enough that handing out every index takes far longer than the
+	// delay before cancel.
+	nums := make([]int, 5_000_000)
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(5*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := SquareAll(ctx, nums, 8)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("SquareAll error = %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("SquareAll took %v after cancellation, want prompt return", elapsed)
+	}
+}